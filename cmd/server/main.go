@@ -13,6 +13,7 @@ import (
 	"vietnamese-converter/internal/api/middleware"
 	"vietnamese-converter/internal/api/routes"
 	"vietnamese-converter/internal/config"
+	"vietnamese-converter/internal/profiles"
 	"vietnamese-converter/pkg/converter"
 	"vietnamese-converter/pkg/logger"
 
@@ -26,6 +27,26 @@ func main() {
 
 	vietnameseConverter := converter.NewVietnameseConverter()
 	convertHandler := handlers.NewConvertHandler(vietnameseConverter, logger)
+
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+
+	// Profiles are validated here so a bad file stops the server before it serves
+	var lexicons *profiles.Store
+	if cfg.Lexicon.File != "" {
+		var err error
+		lexicons, err = profiles.Load(cfg.Lexicon.File)
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("Invalid lexicon profiles: %v", err))
+		}
+		convertHandler.SetLexiconProfiles(lexicons)
+		logger.Info(fmt.Sprintf("Loaded lexicon profiles %v from %s", lexicons.Names(), cfg.Lexicon.File))
+
+		go lexicons.Watch(watchCtx, cfg.Lexicon.ReloadInterval, func(err error) {
+			logger.Error(fmt.Sprintf("Lexicon profiles not reloaded, keeping the previous ones: %v", err))
+		})
+	}
+
 	router := setupRouter(convertHandler, logger)
 	
 	server := &http.Server{
@@ -45,7 +66,26 @@ func main() {
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+
+	// SIGHUP reloads the lexicon profiles without waiting for the next poll
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	for waiting := true; waiting; {
+		select {
+		case <-quit:
+			waiting = false
+		case <-hup:
+			if lexicons == nil {
+				continue
+			}
+			if err := lexicons.Reload(); err != nil {
+				logger.Error(fmt.Sprintf("Lexicon profiles not reloaded, keeping the previous ones: %v", err))
+				continue
+			}
+			logger.Info(fmt.Sprintf("Reloaded lexicon profiles %v", lexicons.Names()))
+		}
+	}
+	stopWatch()
 
 	logger.Info("Shutting down server...")
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -4,33 +4,332 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"vietnamese-converter/pkg/converter"
 	"vietnamese-converter/pkg/logger"
 )
 
+// maxNumberDigits bounds the integer part accepted by the API. Larger numbers
+// convert fine but the "tỷ" chain makes the text grow quadratically.
+const maxNumberDigits = 300
+
 type ConvertResponse struct {
-	Number         int64   `json:"number"`
-	Vietnamese     string  `json:"vietnamese"`
-	ProcessingTimeMs float64 `json:"processing_time_ms"`
+	Number           json.Number       `json:"number,omitempty"`
+	Digits           string            `json:"digits,omitempty"` // the input as given in digits mode
+	Vietnamese       string            `json:"vietnamese,omitempty"`
+	Texts            map[string]string `json:"texts,omitempty"` // the reading per language asked for with lang
+	ProcessingTimeMs float64           `json:"processing_time_ms"`
+}
+
+type convertRequest struct {
+	Number       amountParam `json:"number"`
+	Mode         string      `json:"mode,omitempty"`          // "cardinal" (default), "ordinal" ("thứ nhất"), "digits" ("không chín một") or "han_viet" ("nhất vạn"); currency ignored unless cardinal
+	Currency     string      `json:"currency,omitempty"`      // unit word, or an ISO 4217 code such as "USD"
+	FractionMode string      `json:"fraction_mode,omitempty"` // "minor_unit" (default) or "digits"
+	MinorUnit    string      `json:"minor_unit,omitempty"`
+	Rounding     string      `json:"rounding,omitempty"` // "half_up" (default), "half_even" or "down"
+	// Negative amounts are rejected unless a style is chosen: "word" ("âm ...") or "accounting" ("(...)")
+	NegativeStyle  string `json:"negative_style,omitempty"`
+	NegativePrefix string `json:"negative_prefix,omitempty"`
+	// Wording profile: dialect "northern" (default) or "southern", four_word "tư" or "bốn", five_word "lăm" or "nhăm"
+	Dialect  string `json:"dialect,omitempty"`
+	FourWord string `json:"four_word,omitempty"`
+	FiveWord string `json:"five_word,omitempty"`
+	// Named lexicon profile from the server's LEXICON_FILE; replaces dialect
+	Lexicon string `json:"lexicon,omitempty"`
+	// Ordinal 2 in ordinal mode: second_word "hai" (default) or "nhì"
+	SecondWord string `json:"second_word,omitempty"`
+	// Pauses in digits mode: "mobile" (4-3-3), "tax_code" (10-3), "account" (4-4-...) or a pattern such as "3-3-4"
+	DigitGroups string `json:"digit_groups,omitempty"`
+	// Output shape: "invoice", "cheque", "plain" (default) or "uppercase"; even_suffix overrides the preset's "chẵn"
+	Format     string `json:"format,omitempty"`
+	EvenSuffix *bool  `json:"even_suffix,omitempty"`
+	// Output languages, comma separated: "vi" (default), "en" or "vi,en" for bilingual documents
+	Lang string `json:"lang,omitempty"`
+	// "and" inside English readings: "us" (default, "one hundred five") or "uk" ("one hundred and five")
+	EnglishAnd string `json:"english_and,omitempty"`
+	// Character form: "nfc" (default), "nfd" or "ascii" ("mot trieu dong") for SMS and legacy systems
+	Encoding string `json:"encoding,omitempty"`
+	// Reading style: "formal" (default) or "colloquial" ("một triệu rưỡi", "hai mốt") for chatbots and voice
+	Style string `json:"style,omitempty"`
+	// Zeros inside the number: zero_hundreds "read" (default) or "omit" ("một nghìn hai mươi"),
+	// odd_zero "after_hundreds" (default) or "always" ("một nghìn không trăm lẻ năm"),
+	// empty_groups "silent" (default) or "read" ("một triệu không nghìn không trăm năm")
+	ZeroHundreds string `json:"zero_hundreds,omitempty"`
+	OddZero      string `json:"odd_zero,omitempty"`
+	EmptyGroups  string `json:"empty_groups,omitempty"`
+
+	ssml bool // answer with an SSML document, from the Accept header
+}
+
+// encoding resolves the requested character form
+func (req convertRequest) encoding() (converter.Encoding, error) {
+	switch req.Encoding {
+	case "", "nfc":
+		return converter.EncodingNFC, nil
+	case "nfd":
+		return converter.EncodingNFD, nil
+	case "ascii":
+		return converter.EncodingASCII, nil
+	}
+	return converter.EncodingNFC, fmt.Errorf("unknown encoding %q", req.Encoding)
+}
+
+// languages lists the requested output language codes, Vietnamese when none is given
+func (req convertRequest) languages() ([]string, error) {
+	if req.Lang == "" {
+		return []string{"vi"}, nil
+	}
+
+	switch req.EnglishAnd {
+	case "", "us", "uk":
+	default:
+		return nil, fmt.Errorf("unknown english_and %q", req.EnglishAnd)
+	}
+
+	var codes []string
+	for _, code := range strings.Split(req.Lang, ",") {
+		code = strings.ToLower(strings.TrimSpace(code))
+		if _, ok := converter.LookupLanguage(code); !ok {
+			return nil, fmt.Errorf("unknown lang %q", code)
+		}
+		if !slices.Contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	return codes, nil
+}
+
+// language returns the registered language for code, or English with the
+// requested english_and style
+func (req convertRequest) language(code string) converter.Language {
+	if code == "en" && req.EnglishAnd != "" {
+		and := converter.AndNone
+		if req.EnglishAnd == "uk" {
+			and = converter.AndBritish
+		}
+		return converter.NewEnglish(converter.EnglishOptions{And: and})
+	}
+	lang, _ := converter.LookupLanguage(code)
+	return lang
+}
+
+// vietnameseOnly reports whether the request asks for Vietnamese output alone
+func (req convertRequest) vietnameseOnly() bool {
+	langs, err := req.languages()
+	return err == nil && len(langs) == 1 && langs[0] == "vi"
+}
+
+// formatProfile resolves the format preset and its overrides
+func (req convertRequest) formatProfile() (converter.FormatProfile, error) {
+	name := req.Format
+	if name == "" {
+		name = converter.PresetPlain
+	}
+	profile, ok := converter.Preset(name)
+	if !ok {
+		return profile, fmt.Errorf("unknown format %q", req.Format)
+	}
+
+	if req.EvenSuffix != nil {
+		profile.EvenSuffix = ""
+		if *req.EvenSuffix {
+			profile.EvenSuffix = "chẵn"
+		}
+	}
+
+	enc, err := req.encoding()
+	if err != nil {
+		return profile, err
+	}
+	profile.Encoding = enc
+	return profile, nil
+}
+
+// converterOptions maps the wording fields onto converter options
+func (req convertRequest) converterOptions() ([]converter.Option, error) {
+	var opts []converter.Option
+
+	switch req.Dialect {
+	case "", "northern":
+	case "southern":
+		opts = append(opts, converter.WithDialect(converter.DialectSouthern))
+	default:
+		return nil, fmt.Errorf("unknown dialect %q", req.Dialect)
+	}
+
+	switch req.FourWord {
+	case "", "tư":
+	case "bốn":
+		opts = append(opts, converter.WithFourAsBon())
+	default:
+		return nil, fmt.Errorf("unknown four_word %q", req.FourWord)
+	}
+
+	switch req.FiveWord {
+	case "", "lăm":
+	case "nhăm":
+		opts = append(opts, converter.WithFiveAsNham())
+	default:
+		return nil, fmt.Errorf("unknown five_word %q", req.FiveWord)
+	}
+
+	switch req.SecondWord {
+	case "", "hai":
+	case "nhì":
+		opts = append(opts, converter.WithSecondAsNhi())
+	default:
+		return nil, fmt.Errorf("unknown second_word %q", req.SecondWord)
+	}
+
+	switch req.Style {
+	case "", "formal":
+	case "colloquial":
+		opts = append(opts, converter.WithStyle(converter.StyleColloquial))
+	default:
+		return nil, fmt.Errorf("unknown style %q", req.Style)
+	}
+
+	zeros, err := req.zeroPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if zeros != (converter.ZeroPolicy{}) {
+		opts = append(opts, converter.WithZeroPolicy(zeros))
+	}
+
+	enc, err := req.encoding()
+	if err != nil {
+		return nil, err
+	}
+	if enc != converter.EncodingNFC {
+		opts = append(opts, converter.WithEncoding(enc))
+	}
+
+	return opts, nil
+}
+
+// zeroPolicy maps the zero reading fields onto a converter.ZeroPolicy
+func (req convertRequest) zeroPolicy() (converter.ZeroPolicy, error) {
+	var p converter.ZeroPolicy
+
+	switch req.ZeroHundreds {
+	case "", "read":
+	case "omit":
+		p.OmitZeroHundreds = true
+	default:
+		return p, fmt.Errorf("unknown zero_hundreds %q", req.ZeroHundreds)
+	}
+
+	switch req.OddZero {
+	case "", "after_hundreds":
+	case "always":
+		p.OddZeroAlways = true
+	default:
+		return p, fmt.Errorf("unknown odd_zero %q", req.OddZero)
+	}
+
+	switch req.EmptyGroups {
+	case "", "silent":
+	case "read":
+		p.ReadEmptyGroups = true
+	default:
+		return p, fmt.Errorf("unknown empty_groups %q", req.EmptyGroups)
+	}
+
+	return p, nil
+}
+
+// decimalOptions maps the request fields onto converter.DecimalOptions
+func (req convertRequest) decimalOptions() (converter.DecimalOptions, error) {
+	opts := converter.DefaultDecimalOptions()
+
+	switch req.FractionMode {
+	case "", "minor_unit":
+		opts.Fraction = converter.FractionMinorUnit
+	case "digits":
+		opts.Fraction = converter.FractionDigits
+	default:
+		return opts, fmt.Errorf("unknown fraction_mode %q", req.FractionMode)
+	}
+
+	switch req.Rounding {
+	case "", "half_up":
+		opts.Rounding = converter.RoundHalfUp
+	case "half_even":
+		opts.Rounding = converter.RoundHalfEven
+	case "down":
+		opts.Rounding = converter.RoundDown
+	default:
+		return opts, fmt.Errorf("unknown rounding %q", req.Rounding)
+	}
+
+	switch req.NegativeStyle {
+	case "":
+		opts.Sign.Style = converter.SignReject
+	case "word":
+		opts.Sign.Style = converter.SignWord
+	case "accounting":
+		opts.Sign.Style = converter.SignAccounting
+	default:
+		return opts, fmt.Errorf("unknown negative_style %q", req.NegativeStyle)
+	}
+	opts.Sign.Prefix = req.NegativePrefix
+
+	if c, ok := converter.LookupCurrency(req.Currency); ok {
+		opts = c.DecimalOptions(opts)
+	}
+	if req.MinorUnit != "" {
+		opts.MinorUnit = req.MinorUnit
+	}
+	return opts, nil
+}
+
+// amountParam accepts the number either as a JSON number (1234567.5)
+// or as a string in Vietnamese notation ("1.234.567,50")
+type amountParam string
+
+func (a *amountParam) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*a = amountParam(s)
+		return nil
+	}
+	*a = amountParam(data)
+	return nil
 }
 
 type ErrorResponse struct {
 	Error   string `json:"error"`
+	Code    string `json:"code"` // machine-readable, one of the Code* constants
 	Details string `json:"details,omitempty"`
+	Offset  *int   `json:"offset,omitempty"` // byte offset of the offending input, when known
+	Limit   string `json:"limit,omitempty"`  // the bound that was crossed, for out_of_range
+}
+
+// LexiconProfiles looks up the named lexicons requests may select
+type LexiconProfiles interface {
+	Lookup(name string) (converter.Lexicon, bool)
 }
 
 type ConvertHandler struct {
 	converter converter.NumberConverter
 	logger    logger.Logger
+	profiles  LexiconProfiles
 }
 
-func (h *ConvertHandler) sendError(w http.ResponseWriter, statusCode int, message, details string) {
+func (h *ConvertHandler) sendError(w http.ResponseWriter, statusCode int, code, message, details string) {
 	w.WriteHeader(statusCode)
 	err := ErrorResponse{
 		Error:   message,
+		Code:    code,
 		Details: details,
 	}
 	json.NewEncoder(w).Encode(err)
@@ -44,129 +343,389 @@ func (h *ConvertHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 func (h *ConvertHandler) ConvertNumber(w http.ResponseWriter, r *http.Request) {
 	startTime := time.Now()
 
-	var req struct {
-		Number   int64  `json:"number"`
-		Currency string `json:"currency,omitempty"`
+	var req convertRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.ssml = acceptsSSML(r)
+
+	h.convert(w, startTime, req)
+}
+
+func NewConvertHandler(converter converter.NumberConverter, logger logger.Logger) *ConvertHandler {
+	return &ConvertHandler{
+		converter: converter,
+		logger:    logger,
 	}
+}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.sendError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+// SetLexiconProfiles makes the profiles selectable with the lexicon field
+func (h *ConvertHandler) SetLexiconProfiles(profiles LexiconProfiles) {
+	h.profiles = profiles
+}
+
+func (h *ConvertHandler) ConvertFromURL(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	// Get query parameters
+	query := r.URL.Query()
+	req := convertRequest{
+		Number:       amountParam(query.Get("number")),
+		Mode:         query.Get("mode"),
+		Currency:     query.Get("currency"),
+		FractionMode: query.Get("fraction_mode"),
+		MinorUnit:    query.Get("minor_unit"),
+		Rounding:     query.Get("rounding"),
+
+		NegativeStyle:  query.Get("negative_style"),
+		NegativePrefix: query.Get("negative_prefix"),
+
+		Dialect:  query.Get("dialect"),
+		FourWord: query.Get("four_word"),
+		FiveWord: query.Get("five_word"),
+		Lexicon:  query.Get("lexicon"),
+
+		SecondWord:  query.Get("second_word"),
+		DigitGroups: query.Get("digit_groups"),
+
+		Format:     query.Get("format"),
+		Lang:       query.Get("lang"),
+		EnglishAnd: query.Get("english_and"),
+		Encoding:   query.Get("encoding"),
+		Style:      query.Get("style"),
+
+		ZeroHundreds: query.Get("zero_hundreds"),
+		OddZero:      query.Get("odd_zero"),
+		EmptyGroups:  query.Get("empty_groups"),
+	}
+	if v := query.Get("even_suffix"); v != "" {
+		even, err := strconv.ParseBool(v)
+		if err != nil {
+			h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid even_suffix parameter", err.Error())
+			return
+		}
+		req.EvenSuffix = &even
+	}
+	if req.Number == "" {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Missing number parameter", "")
 		return
 	}
+	req.ssml = acceptsSSML(r)
 
-	// Set default currency if not provided
+	h.convert(w, startTime, req)
+}
+
+// convert validates a parsed request and writes the conversion response
+func (h *ConvertHandler) convert(w http.ResponseWriter, startTime time.Time, req convertRequest) {
+	if req.ssml && !req.vietnameseOnly() {
+		h.sendError(w, http.StatusBadRequest, CodeUnsupported, "SSML is only written in Vietnamese", "")
+		return
+	}
+
+	// Identifiers keep their leading zeros, so they never go through ParseDecimal
+	if req.Mode == "digits" {
+		h.convertDigitSequence(w, startTime, req)
+		return
+	}
+
+	amount, err := converter.ParseDecimal(string(req.Number))
+	if err != nil {
+		h.sendConverterError(w, "Invalid number format", err)
+		return
+	}
+
+	opts, err := req.decimalOptions()
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", err.Error())
+		return
+	}
+
+	format, err := req.formatProfile()
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", err.Error())
+		return
+	}
+
+	conv, err := h.converterFor(req)
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", err.Error())
+		return
+	}
+
+	langs, err := req.languages()
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", err.Error())
+		return
+	}
+
+	// Other languages name registered currencies themselves, so they get the code
+	currencyCode := req.Currency
+	if currencyCode == "" {
+		currencyCode = "VND"
+	}
+
+	// Set default currency if not provided; registered codes read as their unit word
 	if req.Currency == "" {
 		req.Currency = "đồng"
+	} else if c, ok := converter.LookupCurrency(req.Currency); ok {
+		req.Currency = c.Major
 	}
 
 	// Validate input
-	if req.Number < 0 {
-		h.sendError(w, http.StatusBadRequest, "Number must be non-negative", "")
+	if amount.Sign() < 0 && opts.Sign.Style == converter.SignReject {
+		h.sendError(w, http.StatusBadRequest, CodeNegativeNumber, "Number must be non-negative", "Set negative_style to convert negative amounts")
 		return
 	}
 
-	if req.Number > 999999999999999 {
-		h.sendError(w, http.StatusBadRequest, "Number too large", "Maximum supported: 999,999,999,999,999")
+	if len(amount.IntegerDigits()) > maxNumberDigits {
+		h.sendError(w, http.StatusBadRequest, CodeOutOfRange, "Number too large", fmt.Sprintf("Maximum supported: %d digits", maxNumberDigits))
 		return
 	}
 
 	// Convert number
-	vietnamese, err := h.converter.ConvertWithCurrency(req.Number, req.Currency)
-	if err != nil {
-		h.logger.Error(fmt.Sprintf("Conversion failed: %v", err))
-		if err.Error() == "number too large (max: 999,999,999,999,999)" || err.Error() == "negative numbers not supported" {
-			h.sendError(w, http.StatusBadRequest, "Invalid number", err.Error())
-		} else {
-			// For other unexpected errors from converter (e.g. potential panics if not caught by middleware)
-			h.sendError(w, http.StatusInternalServerError, "Conversion failed unexpectedly", err.Error())
+	var vietnamese string
+	switch req.Mode {
+	case "", "cardinal":
+	case "ordinal":
+		if !req.vietnameseOnly() {
+			h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Ordinals are only read in Vietnamese", "")
+			return
+		}
+		h.convertOrdinal(w, startTime, amount, conv, format, req.ssml)
+		return
+	case "han_viet":
+		if !req.vietnameseOnly() {
+			h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Hán-Việt numerals are only read in Vietnamese", "")
+			return
 		}
+		h.convertSinoVietnamese(w, startTime, amount, conv, format, req.ssml)
+		return
+	default:
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", fmt.Sprintf("unknown mode %q", req.Mode))
 		return
 	}
 
-	// Calculate processing time
-	processingTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
+	whole := opts.Round(amount).IsInteger()
+	var texts map[string]string
+	if req.Lang != "" {
+		texts = make(map[string]string, len(langs))
+	}
 
-	// Send response
-	response := ConvertResponse{
-		Number:          req.Number,
-		Vietnamese:      vietnamese,
-		ProcessingTimeMs: processingTime,
+	for _, code := range langs {
+		if code != "vi" {
+			text, err := req.language(code).ConvertAmount(amount, currencyCode, opts)
+			if err != nil {
+				h.sendConverterError(w, "Invalid number", err)
+				return
+			}
+			// "chẵn" is Vietnamese; other languages keep only the capitalization and terminator
+			foreign := format
+			foreign.EvenSuffix = ""
+			texts[code] = foreign.Apply(text, whole)
+			continue
+		}
+
+		if dc, ok := conv.(converter.DecimalConverter); ok {
+			vietnamese, err = dc.ConvertDecimal(amount, req.Currency, opts)
+		} else if n, ok := amount.Int64(); ok && amount.IsInteger() {
+			if sc, ok := conv.(converter.SignedConverter); ok {
+				vietnamese, err = sc.ConvertSigned(n, req.Currency, opts.Sign)
+			} else {
+				vietnamese, err = conv.ConvertWithCurrency(n, req.Currency)
+			}
+		} else {
+			h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Decimal amounts not supported", "")
+			return
+		}
+		if err != nil {
+			h.sendConverterError(w, "Invalid number", err)
+			return
+		}
+
+		vietnamese = format.Apply(vietnamese, whole)
+		if texts != nil {
+			texts[code] = vietnamese
+		}
 	}
 
-	h.logger.WithField("number", strconv.FormatInt(req.Number, 10)).
-		WithField("processing_time_ms", fmt.Sprintf("%.2f", processingTime)).
-		Info("Number converted successfully")
+	if req.ssml {
+		h.sendSSML(w, startTime, amount.String(), cardinalSSML(conv, amount, req.Currency, vietnamese))
+		return
+	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	h.sendConverted(w, startTime, amount, vietnamese, texts)
 }
 
-func NewConvertHandler(converter converter.NumberConverter, logger logger.Logger) *ConvertHandler {
-	return &ConvertHandler{
-		converter: converter,
-		logger:    logger,
+// convertOrdinal handles mode "ordinal": the number must be a positive integer
+func (h *ConvertHandler) convertOrdinal(w http.ResponseWriter, startTime time.Time, amount converter.Decimal, conv converter.NumberConverter, format converter.FormatProfile, ssml bool) {
+	n, ok := amount.Int64()
+	if !ok || !amount.IsInteger() {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidInput, "Invalid number", "Ordinals need a whole number")
+		return
 	}
-}
 
-func (h *ConvertHandler) ConvertFromURL(w http.ResponseWriter, r *http.Request) {
-	startTime := time.Now()
+	oc, ok := conv.(converter.OrdinalConverter)
+	if !ok {
+		h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Ordinals not supported", "")
+		return
+	}
+	vietnamese, err := oc.ConvertOrdinal(n)
+	if err != nil {
+		h.sendConverterError(w, "Invalid number", err)
+		return
+	}
 
-	// Get query parameters
-	numberStr := r.URL.Query().Get("number")
-	if numberStr == "" {
-		h.sendError(w, http.StatusBadRequest, "Missing number parameter", "")
+	// "chẵn" only applies to amounts
+	vietnamese = format.Apply(vietnamese, false)
+	if ssml {
+		h.sendSSML(w, startTime, amount.String(), converter.RenderSSMLText(vietnamese, converter.DefaultSSMLOptions()))
 		return
 	}
+	h.sendConverted(w, startTime, amount, vietnamese, nil)
+}
 
-	currency := r.URL.Query().Get("currency")
-	if currency == "" {
-		currency = "đồng" // Default currency
+// convertSinoVietnamese handles mode "han_viet": the number must be a non-negative integer
+func (h *ConvertHandler) convertSinoVietnamese(w http.ResponseWriter, startTime time.Time, amount converter.Decimal, conv converter.NumberConverter, format converter.FormatProfile, ssml bool) {
+	n, ok := amount.Int64()
+	if !ok || !amount.IsInteger() {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidInput, "Invalid number", "Hán-Việt numerals need a whole number")
+		return
 	}
 
-	number, err := strconv.ParseInt(numberStr, 10, 64)
+	sc, ok := conv.(converter.SinoVietnameseConverter)
+	if !ok {
+		h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Hán-Việt numerals not supported", "")
+		return
+	}
+	vietnamese, err := sc.ConvertSinoVietnamese(n)
 	if err != nil {
-		h.sendError(w, http.StatusBadRequest, "Invalid number format", err.Error())
+		h.sendConverterError(w, "Invalid number", err)
 		return
 	}
 
-	// Validate input
-	if number < 0 {
-		h.sendError(w, http.StatusBadRequest, "Number must be non-negative", "")
+	// "chẵn" only applies to amounts
+	vietnamese = format.Apply(vietnamese, false)
+	if ssml {
+		h.sendSSML(w, startTime, amount.String(), converter.RenderSSMLText(vietnamese, converter.DefaultSSMLOptions()))
 		return
 	}
+	h.sendConverted(w, startTime, amount, vietnamese, nil)
+}
 
-	if number > 999999999999999 {
-		h.sendError(w, http.StatusBadRequest, "Number too large", "Maximum supported: 999,999,999,999,999")
+// convertDigitSequence handles mode "digits": phone, account and ID numbers read digit by digit
+func (h *ConvertHandler) convertDigitSequence(w http.ResponseWriter, startTime time.Time, req convertRequest) {
+	var groups []int
+	switch req.DigitGroups {
+	case "mobile":
+		groups = converter.DigitsMobile
+	case "tax_code":
+		groups = converter.DigitsTaxCode
+	case "account":
+		groups = converter.DigitsAccount
+	default:
+		var err error
+		if groups, err = converter.ParseDigitGroups(req.DigitGroups); err != nil {
+			h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", err.Error())
+			return
+		}
+	}
+
+	format, err := req.formatProfile()
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", err.Error())
+		return
+	}
+	if !req.vietnameseOnly() {
+		h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Digit sequences are only read in Vietnamese", "")
 		return
 	}
 
-	// Convert number
-	vietnamese, err := h.converter.ConvertWithCurrency(number, currency)
+	conv, err := h.converterFor(req)
 	if err != nil {
-		h.logger.Error(fmt.Sprintf("Conversion failed: %v", err))
-		if err.Error() == "number too large (max: 999,999,999,999,999)" || err.Error() == "negative numbers not supported" {
-			h.sendError(w, http.StatusBadRequest, "Invalid number", err.Error())
-		} else {
-			// For other unexpected errors from converter (e.g. potential panics if not caught by middleware)
-			h.sendError(w, http.StatusInternalServerError, "Conversion failed unexpectedly", err.Error())
-		}
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", err.Error())
+		return
+	}
+	dc, ok := conv.(converter.DigitSequenceConverter)
+	if !ok {
+		h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Digit sequences not supported", "")
+		return
+	}
+
+	vietnamese, err := dc.ConvertDigitSequence(string(req.Number), converter.DigitOptions{Groups: groups})
+	if err != nil {
+		h.sendConverterError(w, "Invalid digit sequence", err)
+		return
+	}
+	vietnamese = format.Apply(vietnamese, false)
+	if req.ssml {
+		h.sendSSML(w, startTime, string(req.Number), converter.RenderSSMLText(vietnamese, converter.DefaultSSMLOptions()))
 		return
 	}
 
 	// Calculate processing time
 	processingTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
 
+	h.logger.WithField("processing_time_ms", fmt.Sprintf("%.2f", processingTime)).
+		Info("Digit sequence converted successfully")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(ConvertResponse{
+		Digits:           string(req.Number),
+		Vietnamese:       vietnamese,
+		ProcessingTimeMs: processingTime,
+	})
+}
+
+// sendConverted writes a successful conversion response
+func (h *ConvertHandler) sendConverted(w http.ResponseWriter, startTime time.Time, amount converter.Decimal, vietnamese string, texts map[string]string) {
+	// Calculate processing time
+	processingTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
+
 	// Send response
 	response := ConvertResponse{
-		Number:          number,
-		Vietnamese:      vietnamese,
+		Number:           json.Number(amount.String()),
+		Vietnamese:       vietnamese,
+		Texts:            texts,
 		ProcessingTimeMs: processingTime,
 	}
 
-	h.logger.WithField("number", strconv.FormatInt(number, 10)).
+	h.logger.WithField("number", amount.String()).
 		WithField("processing_time_ms", fmt.Sprintf("%.2f", processingTime)).
 		Info("Number converted successfully")
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
-}
\ No newline at end of file
+}
+
+// converterFor derives a converter with the request's wording options,
+// reusing the shared one when the request asks for the defaults
+func (h *ConvertHandler) converterFor(req convertRequest) (converter.NumberConverter, error) {
+	opts, err := req.converterOptions()
+	if err != nil {
+		return nil, err
+	}
+	if req.Lexicon != "" {
+		if req.Dialect != "" {
+			return nil, fmt.Errorf("lexicon and dialect cannot be combined")
+		}
+		var lex converter.Lexicon
+		var ok bool
+		if h.profiles != nil {
+			lex, ok = h.profiles.Lookup(req.Lexicon)
+		}
+		if !ok {
+			return nil, fmt.Errorf("unknown lexicon %q", req.Lexicon)
+		}
+		opts = append(opts, converter.WithLexicon(lex))
+	}
+	if len(opts) == 0 {
+		return h.converter, nil
+	}
+
+	configurable, ok := h.converter.(converter.Configurable)
+	if !ok {
+		return nil, fmt.Errorf("converter does not support wording options")
+	}
+	return configurable.WithOptions(opts...), nil
+}
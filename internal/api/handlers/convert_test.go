@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+	"vietnamese-converter/pkg/logger"
+)
+
+func TestConvertNumberCurrency(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{"number": 12.5}`, "mười hai đồng năm mươi xu"},
+		{`{"number": 12.5, "currency": "VND"}`, "mười hai đồng năm mươi xu"},
+		{`{"number": "12,5", "currency": "vnd"}`, "mười hai đồng năm mươi xu"},
+		{`{"number": 12.5, "currency": "USD"}`, "mười hai đô la Mỹ năm mươi xen"},
+		{`{"number": "500.000"}`, "năm trăm nghìn đồng"},
+		{`{"number": "1,000"}`, "một nghìn đồng"},
+	}
+
+	for _, engine := range []converter.NumberConverter{converter.NewVietnameseConverter(), converter.NewTurboConverter()} {
+		h := NewConvertHandler(engine, logger.New("error"))
+		for _, tt := range tests {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/convert", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h.ConvertNumber(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Errorf("%T %s: status %d: %s", engine, tt.body, rec.Code, rec.Body)
+				continue
+			}
+			var resp ConvertResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("%T %s: decoding response: %v", engine, tt.body, err)
+			}
+			if resp.Vietnamese != tt.want {
+				t.Errorf("%T %s = %q, want %q", engine, tt.body, resp.Vietnamese, tt.want)
+			}
+		}
+	}
+}
+
+func TestConvertNumberEnglishAnd(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{"number": 1105, "lang": "en"}`, "one thousand one hundred five dong"},
+		{`{"number": 1105, "lang": "en", "english_and": "us"}`, "one thousand one hundred five dong"},
+		{`{"number": 1105, "lang": "vi,en", "english_and": "uk"}`, "one thousand one hundred and five dong"},
+	}
+
+	h := NewConvertHandler(converter.NewTurboConverter(), logger.New("error"))
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/convert", strings.NewReader(tt.body))
+		rec := httptest.NewRecorder()
+		h.ConvertNumber(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("%s: status %d: %s", tt.body, rec.Code, rec.Body)
+			continue
+		}
+		var resp ConvertResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("%s: decoding response: %v", tt.body, err)
+		}
+		if resp.Texts["en"] != tt.want {
+			t.Errorf("%s = %q, want %q", tt.body, resp.Texts["en"], tt.want)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/convert?number=5&lang=en&english_and=au", nil)
+	rec := httptest.NewRecorder()
+	h.ConvertFromURL(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("english_and=au: status %d, want 400", rec.Code)
+	}
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+type DateTimeResponse struct {
+	Date             string  `json:"date,omitempty"`
+	Time             string  `json:"time,omitempty"`
+	Vietnamese       string  `json:"vietnamese"`
+	ProcessingTimeMs float64 `json:"processing_time_ms"`
+}
+
+type dateTimeRequest struct {
+	Date            string `json:"date,omitempty"` // 15/04/2024, 15-04-2024, 15.04.2024 or 2024-04-15
+	Time            string `json:"time,omitempty"` // 08:05 or 08:05:30
+	LunarMonthNames bool   `json:"lunar_month_names,omitempty"`
+	Mong            bool   `json:"mong,omitempty"`
+}
+
+// ConvertDateTime reads a date and/or time from the request body
+func (h *ConvertHandler) ConvertDateTime(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	var req dateTimeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	h.convertDateTime(w, startTime, req)
+}
+
+// ConvertDateTimeFromURL reads a date and/or time from the query parameters
+func (h *ConvertHandler) ConvertDateTimeFromURL(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	query := r.URL.Query()
+	req := dateTimeRequest{
+		Date: query.Get("date"),
+		Time: query.Get("time"),
+	}
+	for name, flag := range map[string]*bool{"lunar_month_names": &req.LunarMonthNames, "mong": &req.Mong} {
+		v := query.Get(name)
+		if v == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, fmt.Sprintf("Invalid %s parameter", name), err.Error())
+			return
+		}
+		*flag = b
+	}
+
+	h.convertDateTime(w, startTime, req)
+}
+
+// convertDateTime reads the time before the date, as spoken: "tám giờ năm phút ngày ..."
+func (h *ConvertHandler) convertDateTime(w http.ResponseWriter, startTime time.Time, req dateTimeRequest) {
+	if req.Date == "" && req.Time == "" {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Missing date or time", "")
+		return
+	}
+
+	dc, ok := h.converter.(converter.DateTimeConverter)
+	if !ok {
+		h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Dates not supported", "")
+		return
+	}
+
+	var vietnamese string
+	if req.Time != "" {
+		hour, minute, second, err := converter.ParseClock(req.Time)
+		if err != nil {
+			h.sendConverterError(w, "Invalid time format", err)
+			return
+		}
+		if vietnamese, err = dc.ConvertTime(hour, minute, second); err != nil {
+			h.sendConverterError(w, "Invalid time", err)
+			return
+		}
+	}
+	if req.Date != "" {
+		date, err := converter.ParseDate(req.Date)
+		if err != nil {
+			h.sendConverterError(w, "Invalid date format", err)
+			return
+		}
+		text, err := dc.ConvertDate(date, converter.DateOptions{
+			LunarMonthNames: req.LunarMonthNames,
+			Mong:            req.Mong,
+		})
+		if err != nil {
+			h.sendConverterError(w, "Invalid date", err)
+			return
+		}
+		if vietnamese != "" {
+			vietnamese += " "
+		}
+		vietnamese += text
+	}
+
+	// Calculate processing time
+	processingTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
+
+	response := DateTimeResponse{
+		Date:             req.Date,
+		Time:             req.Time,
+		Vietnamese:       vietnamese,
+		ProcessingTimeMs: processingTime,
+	}
+
+	h.logger.WithField("date", req.Date).
+		WithField("time", req.Time).
+		WithField("processing_time_ms", fmt.Sprintf("%.2f", processingTime)).
+		Info("Date converted successfully")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+// Machine-readable error codes returned in ErrorResponse.Code
+const (
+	CodeInvalidRequest = "invalid_request" // malformed body, missing or unknown parameters
+	CodeInvalidInput   = "invalid_input"   // the number, text, date or time cannot be read
+	CodeNegativeNumber = "negative_number" // negative amount without a negative_style
+	CodeOutOfRange     = "out_of_range"    // beyond a limit, see ErrorResponse.Limit
+	CodeUnsupported    = "unsupported"     // the configured converter lacks the feature
+	CodeInternal       = "internal_error"
+)
+
+// sendConverterError maps errors from pkg/converter onto a status and error code.
+// Errors that match none of the converter's sentinels are unexpected and logged.
+func (h *ConvertHandler) sendConverterError(w http.ResponseWriter, message string, err error) {
+	status := http.StatusBadRequest
+	resp := ErrorResponse{
+		Error:   message,
+		Details: err.Error(),
+	}
+
+	switch {
+	case errors.Is(err, converter.ErrNegative):
+		resp.Code = CodeNegativeNumber
+	case errors.Is(err, converter.ErrOutOfRange):
+		resp.Code = CodeOutOfRange
+	case errors.Is(err, converter.ErrInvalidInput):
+		resp.Code = CodeInvalidInput
+	default:
+		h.logger.Error(fmt.Sprintf("Conversion failed: %v", err))
+		status = http.StatusInternalServerError
+		resp.Error = "Conversion failed unexpectedly"
+		resp.Code = CodeInternal
+	}
+
+	var ce *converter.Error
+	if errors.As(err, &ce) {
+		resp.Limit = ce.Limit
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
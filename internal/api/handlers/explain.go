@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+type ExplainResponse struct {
+	Number           json.Number       `json:"number"`
+	Vietnamese       string            `json:"vietnamese"`
+	Digits           string            `json:"digits"` // the digits the token ranges index
+	Tokens           []converter.Token `json:"tokens"`
+	ProcessingTimeMs float64           `json:"processing_time_ms"`
+}
+
+// ExplainNumber converts the number from the request body and returns
+// which digits produced each word
+func (h *ConvertHandler) ExplainNumber(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	var req convertRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	h.explain(w, startTime, req)
+}
+
+// ExplainFromURL is ExplainNumber with the number and wording options as query parameters
+func (h *ConvertHandler) ExplainFromURL(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	query := r.URL.Query()
+	req := convertRequest{
+		Number:   amountParam(query.Get("number")),
+		Currency: query.Get("currency"),
+
+		Dialect:  query.Get("dialect"),
+		FourWord: query.Get("four_word"),
+		FiveWord: query.Get("five_word"),
+		Lexicon:  query.Get("lexicon"),
+
+		Encoding: query.Get("encoding"),
+		Style:    query.Get("style"),
+
+		ZeroHundreds: query.Get("zero_hundreds"),
+		OddZero:      query.Get("odd_zero"),
+		EmptyGroups:  query.Get("empty_groups"),
+	}
+	if req.Number == "" {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Missing number parameter", "")
+		return
+	}
+
+	h.explain(w, startTime, req)
+}
+
+// explain reads whole non-negative numbers only: fractions, signs and output
+// formats add words that come from no digit
+func (h *ConvertHandler) explain(w http.ResponseWriter, startTime time.Time, req convertRequest) {
+	amount, err := converter.ParseDecimal(string(req.Number))
+	if err != nil {
+		h.sendConverterError(w, "Invalid number format", err)
+		return
+	}
+	if amount.Sign() < 0 {
+		h.sendError(w, http.StatusBadRequest, CodeNegativeNumber, "Number must be non-negative", "Explain reads the digits of non-negative numbers")
+		return
+	}
+	if !amount.IsInteger() {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidInput, "Invalid number", "Explain needs a whole number")
+		return
+	}
+	if len(amount.IntegerDigits()) > maxNumberDigits {
+		h.sendError(w, http.StatusBadRequest, CodeOutOfRange, "Number too large", fmt.Sprintf("Maximum supported: %d digits", maxNumberDigits))
+		return
+	}
+
+	conv, err := h.converterFor(req)
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", err.Error())
+		return
+	}
+	explainer, ok := conv.(converter.Explainer)
+	if !ok {
+		h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Explain not supported", "")
+		return
+	}
+
+	if req.Currency == "" {
+		req.Currency = "đồng"
+	} else if c, ok := converter.LookupCurrency(req.Currency); ok {
+		req.Currency = c.Major
+	}
+
+	explanation, err := explainer.Explain(amount.IntegerDigits(), req.Currency)
+	if err != nil {
+		h.sendConverterError(w, "Invalid number", err)
+		return
+	}
+
+	// Calculate processing time
+	processingTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
+
+	h.logger.WithField("number", amount.String()).
+		WithField("tokens", fmt.Sprintf("%d", len(explanation.Tokens))).
+		WithField("processing_time_ms", fmt.Sprintf("%.2f", processingTime)).
+		Info("Number explained successfully")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(ExplainResponse{
+		Number:           json.Number(amount.String()),
+		Vietnamese:       explanation.Text(),
+		Digits:           explanation.Digits,
+		Tokens:           explanation.Tokens,
+		ProcessingTimeMs: processingTime,
+	})
+}
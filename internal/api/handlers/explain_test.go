@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+	"vietnamese-converter/pkg/logger"
+)
+
+func TestExplainNumberEngines(t *testing.T) {
+	engines := map[string]converter.NumberConverter{
+		"vietnamese": converter.NewVietnameseConverter(),
+		"turbo":      converter.NewTurboConverter(),
+	}
+	for name, engine := range engines {
+		h := NewConvertHandler(engine, logger.New("error"))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/explain", strings.NewReader(`{"number": 1005000, "dialect": "southern"}`))
+		rec := httptest.NewRecorder()
+		h.ExplainNumber(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: explain status %d: %s", name, rec.Code, rec.Body)
+		}
+		var resp ExplainResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("%s: decoding response: %v", name, err)
+		}
+		if want := "một triệu không trăm năm ngàn đồng"; resp.Vietnamese != want || len(resp.Tokens) != 6 {
+			t.Errorf("%s: explain = %q with %d tokens, want %q with 6", name, resp.Vietnamese, len(resp.Tokens), want)
+		}
+
+		// SSML pauses between digit groups when the engine explains its reading
+		req = httptest.NewRequest(http.MethodPost, "/api/v1/convert", strings.NewReader(`{"number": 1005000}`))
+		req.Header.Set("Accept", ssmlContentType)
+		rec = httptest.NewRecorder()
+		h.ConvertNumber(rec, req)
+		if want := `một triệu<break time="150ms"/>không trăm năm nghìn đồng`; !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("%s: SSML %s does not contain %s", name, rec.Body, want)
+		}
+	}
+}
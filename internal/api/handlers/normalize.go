@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"vietnamese-converter/pkg/normalizer"
+)
+
+type NormalizeResponse struct {
+	Text             string            `json:"text"`
+	Normalized       string            `json:"normalized"`
+	Spans            []normalizer.Span `json:"spans"`
+	ProcessingTimeMs float64           `json:"processing_time_ms"`
+}
+
+// NormalizeText spells out the numbers in the text from the request body
+func (h *ConvertHandler) NormalizeText(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	var req struct {
+		Text string `json:"text"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	h.normalize(w, startTime, req.Text)
+}
+
+// NormalizeFromURL spells out the numbers in the text query parameter
+func (h *ConvertHandler) NormalizeFromURL(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	text := r.URL.Query().Get("text")
+	if text == "" {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Missing text parameter", "")
+		return
+	}
+
+	h.normalize(w, startTime, text)
+}
+
+func (h *ConvertHandler) normalize(w http.ResponseWriter, startTime time.Time, text string) {
+	conv, ok := h.converter.(normalizer.Converter)
+	if !ok {
+		h.sendError(w, http.StatusBadRequest, CodeUnsupported, "Normalization not supported", "")
+		return
+	}
+
+	result := normalizer.New(conv).Normalize(text)
+	spans := result.Spans
+	if spans == nil {
+		spans = []normalizer.Span{}
+	}
+
+	// Calculate processing time
+	processingTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
+
+	response := NormalizeResponse{
+		Text:             text,
+		Normalized:       result.Text,
+		Spans:            spans,
+		ProcessingTimeMs: processingTime,
+	}
+
+	h.logger.WithField("spans", fmt.Sprintf("%d", len(spans))).
+		WithField("processing_time_ms", fmt.Sprintf("%.2f", processingTime)).
+		Info("Text normalized successfully")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
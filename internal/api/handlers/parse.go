@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+type ParseResponse struct {
+	Text             string      `json:"text"`
+	Number           json.Number `json:"number"`
+	Currency         string      `json:"currency,omitempty"`
+	ProcessingTimeMs float64     `json:"processing_time_ms"`
+}
+
+// ParseText reads Vietnamese number words from the request body back into a number
+func (h *ConvertHandler) ParseText(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	var req struct {
+		Text string `json:"text"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	h.parse(w, startTime, req.Text)
+}
+
+// ParseFromURL reads Vietnamese number words from the text query parameter
+func (h *ConvertHandler) ParseFromURL(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	text := r.URL.Query().Get("text")
+	if text == "" {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Missing text parameter", "")
+		return
+	}
+
+	h.parse(w, startTime, text)
+}
+
+func (h *ConvertHandler) parse(w http.ResponseWriter, startTime time.Time, text string) {
+	amount, err := converter.ParseAmount(text)
+	if err != nil {
+		var pe *converter.ParseError
+		if errors.As(err, &pe) {
+			h.sendParseError(w, pe)
+			return
+		}
+		h.sendError(w, http.StatusInternalServerError, CodeInternal, "Parsing failed unexpectedly", err.Error())
+		return
+	}
+
+	// Calculate processing time
+	processingTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
+
+	response := ParseResponse{
+		Text:             text,
+		Number:           json.Number(amount.Value.String()),
+		Currency:         amount.Currency,
+		ProcessingTimeMs: processingTime,
+	}
+
+	h.logger.WithField("number", amount.Value.String()).
+		WithField("processing_time_ms", fmt.Sprintf("%.2f", processingTime)).
+		Info("Text parsed successfully")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// sendParseError reports the position of the offending word so clients can highlight it
+func (h *ConvertHandler) sendParseError(w http.ResponseWriter, pe *converter.ParseError) {
+	offset := pe.Offset
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   "Invalid number text",
+		Code:    CodeInvalidInput,
+		Details: pe.Error(),
+		Offset:  &offset,
+	})
+}
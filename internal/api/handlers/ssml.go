@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+const ssmlContentType = "application/ssml+xml"
+
+// acceptsSSML reports whether the client asked for an SSML document instead of JSON
+func acceptsSSML(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept)); err == nil && mediaType == ssmlContentType {
+			return true
+		}
+	}
+	return false
+}
+
+// cardinalSSML renders a cardinal reading as SSML, with a <break> between digit
+// groups when the converter can explain it and the output format left the
+// reading unchanged; otherwise the text is spoken as a whole
+func cardinalSSML(conv converter.NumberConverter, amount converter.Decimal, currency, vietnamese string) string {
+	opts := converter.DefaultSSMLOptions()
+	if explainer, ok := conv.(converter.Explainer); ok && amount.Sign() >= 0 && amount.IsInteger() {
+		explanation, err := explainer.Explain(amount.IntegerDigits(), currency)
+		if err == nil && explanation.Text() == vietnamese {
+			return converter.RenderSSML(explanation, opts)
+		}
+	}
+	return converter.RenderSSMLText(vietnamese, opts)
+}
+
+// sendSSML writes a successful conversion as an SSML document
+func (h *ConvertHandler) sendSSML(w http.ResponseWriter, startTime time.Time, number, ssml string) {
+	// Calculate processing time
+	processingTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
+
+	h.logger.WithField("number", number).
+		WithField("processing_time_ms", fmt.Sprintf("%.2f", processingTime)).
+		Info("Number converted to SSML successfully")
+
+	w.Header().Set("Content-Type", ssmlContentType+"; charset=utf-8")
+	w.Write([]byte(ssml))
+}
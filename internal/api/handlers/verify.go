@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+type VerifyResponse struct {
+	Number json.Number `json:"number"`
+	Text   string      `json:"text"`
+	converter.Verification
+	ProcessingTimeMs float64 `json:"processing_time_ms"`
+}
+
+// verifyRequest is a number with the candidate text that should read it
+type verifyRequest struct {
+	convertRequest
+	Text string `json:"text"`
+}
+
+// VerifyAmount checks the amount in words from the request body against its number
+func (h *ConvertHandler) VerifyAmount(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	var req verifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	h.verify(w, startTime, req)
+}
+
+// VerifyFromURL is VerifyAmount with the number, text and wording options as query parameters
+func (h *ConvertHandler) VerifyFromURL(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	query := r.URL.Query()
+	req := verifyRequest{
+		convertRequest: convertRequest{
+			Number: amountParam(query.Get("number")),
+
+			Dialect:  query.Get("dialect"),
+			FourWord: query.Get("four_word"),
+			FiveWord: query.Get("five_word"),
+			Lexicon:  query.Get("lexicon"),
+
+			Style: query.Get("style"),
+
+			ZeroHundreds: query.Get("zero_hundreds"),
+			OddZero:      query.Get("odd_zero"),
+			EmptyGroups:  query.Get("empty_groups"),
+		},
+		Text: query.Get("text"),
+	}
+	if req.Number == "" {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Missing number parameter", "")
+		return
+	}
+
+	h.verify(w, startTime, req)
+}
+
+// verify checks whole non-negative amounts only, like explain; a mismatch is
+// a successful verification, so it answers 200 with match false
+func (h *ConvertHandler) verify(w http.ResponseWriter, startTime time.Time, req verifyRequest) {
+	if req.Text == "" {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Missing text", "")
+		return
+	}
+
+	amount, err := converter.ParseDecimal(string(req.Number))
+	if err != nil {
+		h.sendConverterError(w, "Invalid number format", err)
+		return
+	}
+	if amount.Sign() < 0 {
+		h.sendError(w, http.StatusBadRequest, CodeNegativeNumber, "Number must be non-negative", "Verify checks non-negative amounts")
+		return
+	}
+	if !amount.IsInteger() {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidInput, "Invalid number", "Verify needs a whole number")
+		return
+	}
+	if len(amount.IntegerDigits()) > maxNumberDigits {
+		h.sendError(w, http.StatusBadRequest, CodeOutOfRange, "Number too large", fmt.Sprintf("Maximum supported: %d digits", maxNumberDigits))
+		return
+	}
+
+	conv, err := h.converterFor(req.convertRequest)
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, CodeInvalidRequest, "Invalid conversion options", err.Error())
+		return
+	}
+	verification, err := converter.Verify(conv, amount.IntegerDigits(), req.Text)
+	if err != nil {
+		h.sendConverterError(w, "Invalid number", err)
+		return
+	}
+
+	// Calculate processing time
+	processingTime := float64(time.Since(startTime).Nanoseconds()) / 1e6
+
+	h.logger.WithField("number", amount.String()).
+		WithField("status", string(verification.Status)).
+		WithField("processing_time_ms", fmt.Sprintf("%.2f", processingTime)).
+		Info("Amount verified")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(VerifyResponse{
+		Number:           json.Number(amount.String()),
+		Text:             req.Text,
+		Verification:     verification,
+		ProcessingTimeMs: processingTime,
+	})
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+	"vietnamese-converter/pkg/logger"
+)
+
+func TestVerifyAmountColloquial(t *testing.T) {
+	engines := map[string]converter.NumberConverter{
+		"vietnamese": converter.NewVietnameseConverter(),
+		"turbo":      converter.NewTurboConverter(),
+	}
+	for name, engine := range engines {
+		h := NewConvertHandler(engine, logger.New("error"))
+
+		for _, n := range []int64{21, 1_200_000, 1_500_000, 2_400_000_000} {
+			// The text is the converter's own colloquial reading
+			text, err := converter.NewVietnameseConverter(converter.WithStyle(converter.StyleColloquial)).Convert(n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, _ := json.Marshal(map[string]any{"number": n, "text": text, "style": "colloquial"})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/verify", strings.NewReader(string(body)))
+			rec := httptest.NewRecorder()
+			h.VerifyAmount(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("%s: verify %q status %d: %s", name, text, rec.Code, rec.Body)
+			}
+			var resp VerifyResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("%s: decoding response: %v", name, err)
+			}
+			if !resp.Match || resp.Status != converter.VerifyExact {
+				t.Errorf("%s: verify %d %q = match %v, %s, want an exact match", name, n, text, resp.Match, resp.Status)
+			}
+		}
+	}
+}
@@ -11,6 +11,16 @@ func SetupConvertRoutes(r *chi.Mux, convertHandler *handlers.ConvertHandler) {
 	r.Route("/api/v1", func(r chi.Router) {
 		r.Post("/convert", convertHandler.ConvertNumber)
 		r.Get("/convert", convertHandler.ConvertFromURL)
+		r.Post("/explain", convertHandler.ExplainNumber)
+		r.Get("/explain", convertHandler.ExplainFromURL)
+		r.Post("/verify", convertHandler.VerifyAmount)
+		r.Get("/verify", convertHandler.VerifyFromURL)
+		r.Post("/parse", convertHandler.ParseText)
+		r.Get("/parse", convertHandler.ParseFromURL)
+		r.Post("/datetime", convertHandler.ConvertDateTime)
+		r.Get("/datetime", convertHandler.ConvertDateTimeFromURL)
+		r.Post("/normalize", convertHandler.NormalizeText)
+		r.Get("/normalize", convertHandler.NormalizeFromURL)
 	})
 	
 	r.Get("/health", convertHandler.HealthCheck)
@@ -7,8 +7,9 @@ import (
 )
 
 type Config struct {
-	Server ServerConfig `json:"server"`
-	Log    LogConfig    `json:"log"`
+	Server  ServerConfig  `json:"server"`
+	Log     LogConfig     `json:"log"`
+	Lexicon LexiconConfig `json:"lexicon"`
 }
 
 type ServerConfig struct {
@@ -22,6 +23,12 @@ type LogConfig struct {
 	Level string `json:"level"`
 }
 
+// LexiconConfig points at the lexicon profiles file; no file means no profiles
+type LexiconConfig struct {
+	File           string        `json:"file"`
+	ReloadInterval time.Duration `json:"reload_interval"`
+}
+
 func Load() *Config {
 	port := 8080
 	if portStr := os.Getenv("PORT"); portStr != "" {
@@ -40,5 +47,9 @@ func Load() *Config {
 		Log: LogConfig{
 			Level: "info",
 		},
+		Lexicon: LexiconConfig{
+			File:           os.Getenv("LEXICON_FILE"),
+			ReloadInterval: 5 * time.Second,
+		},
 	}
 }
@@ -0,0 +1,111 @@
+// Package profiles keeps the lexicon profiles file loaded and reloads it
+// when it changes on disk
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+// Store holds the profiles read from one file. It is safe for concurrent use.
+type Store struct {
+	path string
+
+	mu       sync.RWMutex
+	profiles map[string]converter.Lexicon
+	modTime  time.Time
+}
+
+// Load reads and validates the profiles file at path. The format follows
+// the extension: .yaml and .yml are YAML, anything else JSON.
+func Load(path string) (*Store, error) {
+	s := &Store{path: path}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Lookup returns the lexicon of a named profile
+func (s *Store) Lookup(name string) (converter.Lexicon, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	lex, ok := s.profiles[name]
+	return lex, ok
+}
+
+// Names lists the loaded profile names in sorted order
+func (s *Store) Names() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.profiles))
+	for name := range s.profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Reload reads the file again. On any error the profiles loaded before stay in use.
+func (s *Store) Reload() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("lexicon profiles: %w", err)
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("lexicon profiles: %w", err)
+	}
+	profiles, err := converter.ParseLexiconProfiles(data, converter.LexiconFormat(s.path))
+	if err != nil {
+		return fmt.Errorf("%s: %w", s.path, err)
+	}
+
+	s.mu.Lock()
+	s.profiles = profiles
+	s.modTime = info.ModTime()
+	s.mu.Unlock()
+	return nil
+}
+
+// Watch polls the file every interval and reloads it when its modification
+// time changes, until ctx is done. Failed reloads are passed to onError.
+func (s *Store) Watch(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// seen is the last version tried, so a broken file is reported once
+	s.mu.RLock()
+	seen := s.modTime
+	s.mu.RUnlock()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(s.path)
+		if err != nil {
+			if !seen.IsZero() {
+				onError(fmt.Errorf("lexicon profiles: %w", err))
+				seen = time.Time{}
+			}
+			continue
+		}
+		if info.ModTime().Equal(seen) {
+			continue
+		}
+		seen = info.ModTime()
+		if err := s.Reload(); err != nil {
+			onError(err)
+		}
+	}
+}
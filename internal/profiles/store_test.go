@@ -0,0 +1,156 @@
+package profiles_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"vietnamese-converter/internal/profiles"
+)
+
+const (
+	bankProfiles   = `{"profiles": {"bank": {"base": "southern", "billion": "tỉ"}}}`
+	linhProfiles   = `{"profiles": {"linh": {"odd_zero": "linh"}, "nham": {"five_after_tens": "nhăm"}}}`
+	brokenProfiles = `{"profiles": {"bank": {"base": "martian"}}}`
+)
+
+// writeProfiles replaces the file at path with doc, modified version seconds
+// after a fixed base so each rewrite is a new version however fast it follows.
+// The file is renamed into place, so a watcher never sees it half written.
+func writeProfiles(t *testing.T, path, doc string, version int) {
+	t.Helper()
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(doc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	modTime := time.Date(2024, 1, 1, 0, 0, version, 0, time.UTC)
+	if err := os.Chtimes(tmp, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStoreReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	if _, err := profiles.Load(path); err == nil {
+		t.Error("Load of a missing file succeeded")
+	}
+
+	writeProfiles(t, path, bankProfiles, 1)
+	store, err := profiles.Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if lex, ok := store.Lookup("bank"); !ok || lex.Billion != "tỉ" {
+		t.Errorf("Lookup(bank) = %+v, %v", lex, ok)
+	}
+
+	writeProfiles(t, path, linhProfiles, 2)
+	if err := store.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if got, want := store.Names(), []string{"linh", "nham"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() after rewrite = %v, want %v", got, want)
+	}
+	if _, ok := store.Lookup("bank"); ok {
+		t.Error("profile bank kept after it was removed from the file")
+	}
+
+	// A broken file or a deleted one leaves the loaded profiles in use
+	writeProfiles(t, path, brokenProfiles, 3)
+	if err := store.Reload(); err == nil {
+		t.Error("Reload of a broken file succeeded")
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Reload(); err == nil {
+		t.Error("Reload of a deleted file succeeded")
+	}
+	if got, want := store.Names(), []string{"linh", "nham"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() after failed reloads = %v, want %v", got, want)
+	}
+}
+
+func TestStoreWatch(t *testing.T) {
+	const interval = 5 * time.Millisecond
+
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	writeProfiles(t, path, bankProfiles, 1)
+	store, err := profiles.Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	errs := make(chan error, 16)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		store.Watch(ctx, interval, func(err error) { errs <- err })
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// expectErrors waits for n errors, then checks no more arrive while the
+	// watcher polls the same file version again
+	expectErrors := func(step string, n int) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			select {
+			case <-errs:
+			case <-time.After(2 * time.Second):
+				t.Fatalf("%s: no error reported", step)
+			}
+		}
+		select {
+		case err := <-errs:
+			t.Errorf("%s: error reported again: %v", step, err)
+		case <-time.After(20 * interval):
+		}
+	}
+	waitFor := func(step, name string) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for {
+			if _, ok := store.Lookup(name); ok {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("%s: profile %q not loaded, have %v", step, name, store.Names())
+			}
+			time.Sleep(interval)
+		}
+	}
+
+	writeProfiles(t, path, linhProfiles, 2)
+	waitFor("rewrite", "linh")
+	expectErrors("rewrite", 0)
+
+	writeProfiles(t, path, brokenProfiles, 3)
+	expectErrors("broken rewrite", 1)
+	if got, want := store.Names(), []string{"linh", "nham"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() after broken rewrite = %v, want %v", got, want)
+	}
+
+	writeProfiles(t, path, bankProfiles, 4)
+	waitFor("fixed rewrite", "bank")
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	expectErrors("deletion", 1)
+	if _, ok := store.Lookup("bank"); !ok {
+		t.Errorf("profile bank dropped after the file was deleted, have %v", store.Names())
+	}
+
+	writeProfiles(t, path, linhProfiles, 5)
+	waitFor("recreate", "linh")
+}
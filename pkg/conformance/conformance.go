@@ -0,0 +1,276 @@
+// Package conformance checks that every number converter engine reads numbers
+// the same way. It runs all registered engines over an exhaustive small range,
+// stratified random samples and the golden dataset, and reports the cases
+// where their readings disagree.
+package conformance
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"vietnamese-converter/pkg/converter"
+	"vietnamese-converter/pkg/converter/testutil"
+	"vietnamese-converter/pkg/turbo"
+)
+
+// Engine is a named converter implementation under test
+type Engine struct {
+	Name      string
+	Converter converter.NumberConverter
+}
+
+var (
+	engineMu sync.RWMutex
+	engines  = map[string]converter.NumberConverter{}
+)
+
+func init() {
+	engines["vietnamese"] = converter.NewVietnameseConverter()
+	engines["turbo"] = converter.NewTurboConverter()
+	engines["zero_alloc"] = turbo.NewZeroAllocConverter()
+}
+
+// Register adds an engine to the harness, replacing any engine with the same name
+func Register(name string, conv converter.NumberConverter) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("conformance: missing engine name")
+	}
+	if conv == nil {
+		return fmt.Errorf("conformance: engine %s: nil converter", name)
+	}
+
+	engineMu.Lock()
+	engines[name] = conv
+	engineMu.Unlock()
+	return nil
+}
+
+// Engines lists the registered engines sorted by name
+func Engines() []Engine {
+	engineMu.RLock()
+	defer engineMu.RUnlock()
+
+	list := make([]Engine, 0, len(engines))
+	for name, conv := range engines {
+		list = append(list, Engine{Name: name, Converter: conv})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Config selects the inputs of a conformance run
+type Config struct {
+	// ExhaustiveUpTo checks every number from 0 to this bound
+	ExhaustiveUpTo int64
+	// Samples is the number of random numbers drawn, spread evenly over digit counts
+	Samples int
+	// Seed makes the random samples reproducible
+	Seed int64
+	// GoldenFile is a "number reading" dataset; empty skips it
+	GoldenFile string
+	// MaxDivergences caps how many divergences are kept in the report
+	MaxDivergences int
+}
+
+// DefaultConfig checks every number up to 100 000, 19 000 random samples
+// and the golden dataset at goldenFile
+func DefaultConfig(goldenFile string) Config {
+	return Config{
+		ExhaustiveUpTo: 100000,
+		Samples:        19000,
+		Seed:           1,
+		GoldenFile:     goldenFile,
+		MaxDivergences: 20,
+	}
+}
+
+// Source tells which stage of a run produced a case
+type Source string
+
+const (
+	SourceExhaustive Source = "exhaustive"
+	SourceBoundary   Source = "boundary"
+	SourceRandom     Source = "random"
+	SourceGolden     Source = "golden"
+)
+
+// Divergence is one number the engines do not read the same way
+type Divergence struct {
+	Number int64
+	// Pattern shows the digit groups with non-zero digits as 'x': 1005000 is "x.00x.000"
+	Pattern  string
+	Source   Source
+	Expected string            // golden reading, empty outside the golden dataset
+	Readings map[string]string // engine name -> reading, or "error: ..."
+}
+
+// Report summarises a conformance run
+type Report struct {
+	Engines     []string
+	Checked     int
+	Diverged    int // all diverging numbers, including those beyond MaxDivergences
+	Divergences []Divergence
+}
+
+// OK reports whether every engine agreed on every number
+func (r Report) OK() bool {
+	return r.Diverged == 0
+}
+
+// WriteTo prints the report, one block per kept divergence
+func (r Report) WriteTo(w io.Writer) (int64, error) {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "engines: %s\n", strings.Join(r.Engines, ", "))
+	fmt.Fprintf(&sb, "checked %d numbers, %d diverged\n", r.Checked, r.Diverged)
+	for _, d := range r.Divergences {
+		fmt.Fprintf(&sb, "\n%d [%s] (%s)\n", d.Number, d.Pattern, d.Source)
+		if d.Expected != "" {
+			fmt.Fprintf(&sb, "  %-12s %s\n", "expected", d.Expected)
+		}
+		for _, name := range r.Engines {
+			fmt.Fprintf(&sb, "  %-12s %s\n", name, d.Readings[name])
+		}
+	}
+	n, err := io.WriteString(w, sb.String())
+	return int64(n), err
+}
+
+// Run reads every case with all engines and collects the divergences
+func Run(list []Engine, cfg Config) (Report, error) {
+	report := Report{Engines: make([]string, len(list))}
+	for i, e := range list {
+		report.Engines[i] = e.Name
+	}
+	if len(list) == 0 {
+		return report, fmt.Errorf("conformance: no engines to compare")
+	}
+
+	readings := make([]string, len(list))
+	check := func(number int64, source Source, expected string) {
+		report.Checked++
+		agree := true
+		for i, e := range list {
+			text, err := e.Converter.Convert(number)
+			if err != nil {
+				text = "error: " + err.Error()
+			}
+			readings[i] = text
+			if text != readings[0] || (expected != "" && text != expected) {
+				agree = false
+			}
+		}
+		if agree {
+			return
+		}
+
+		report.Diverged++
+		if len(report.Divergences) >= cfg.MaxDivergences {
+			return
+		}
+		d := Divergence{
+			Number:   number,
+			Pattern:  GroupPattern(number),
+			Source:   source,
+			Expected: expected,
+			Readings: make(map[string]string, len(list)),
+		}
+		for i, e := range list {
+			d.Readings[e.Name] = readings[i]
+		}
+		report.Divergences = append(report.Divergences, d)
+	}
+
+	for n := int64(0); n <= cfg.ExhaustiveUpTo; n++ {
+		check(n, SourceExhaustive, "")
+	}
+	for _, n := range boundaries() {
+		check(n, SourceBoundary, "")
+	}
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	for i := 0; i < cfg.Samples; i++ {
+		// Cycle through 1 to 19 digits so long numbers are as common as short ones
+		check(sample(rng, i%19+1), SourceRandom, "")
+	}
+
+	if cfg.GoldenFile != "" {
+		loader := testutil.NewTestDataLoader()
+		if err := loader.LoadTestCases(cfg.GoldenFile); err != nil {
+			return report, err
+		}
+		for _, tc := range loader.GetTestCases() {
+			check(tc.Number, SourceGolden, tc.ExpectedVietnamese)
+		}
+	}
+
+	return report, nil
+}
+
+// boundaries lists the numbers around each power of ten and math.MaxInt64
+func boundaries() []int64 {
+	list := []int64{math.MaxInt64 - 1, math.MaxInt64}
+	for p := int64(10); p <= 1e18; p *= 10 {
+		list = append(list, p-1, p, p+1)
+	}
+	return list
+}
+
+// sample draws a number with exactly digits digits. Each three-digit group
+// below the leading one is zeroed a third of the time, and otherwise has each
+// digit zeroed half of the time, so that zero-group readings are well covered.
+func sample(rng *rand.Rand, digits int) int64 {
+	var b [19]byte
+	for i := 0; i < digits; i++ {
+		if i == 0 {
+			b[i] = byte('1' + rng.Intn(9))
+			continue
+		}
+		b[i] = byte('0' + rng.Intn(10))
+		if rng.Intn(2) == 0 {
+			b[i] = '0'
+		}
+	}
+	for end := digits; end-3 > 0; end -= 3 {
+		if rng.Intn(3) == 0 {
+			copy(b[end-3:end], "000")
+		}
+	}
+
+	n, _ := strconv.ParseUint(string(b[:digits]), 10, 64)
+	if n > math.MaxInt64 {
+		// 19-digit draws above math.MaxInt64 fold back into range
+		n %= math.MaxInt64
+	}
+	return int64(n)
+}
+
+// GroupPattern shows the shape of number's three-digit groups, with non-zero
+// digits as 'x': 1005000 is "x.00x.000" and 120 is "xx0"
+func GroupPattern(number int64) string {
+	digits := strconv.FormatInt(number, 10)
+	negative := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+
+	var sb strings.Builder
+	if negative {
+		sb.WriteByte('-')
+	}
+	for i := 0; i < len(digits); i++ {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			sb.WriteByte('.')
+		}
+		if digits[i] == '0' {
+			sb.WriteByte('0')
+		} else {
+			sb.WriteByte('x')
+		}
+	}
+	return sb.String()
+}
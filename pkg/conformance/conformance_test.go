@@ -0,0 +1,166 @@
+package conformance_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"vietnamese-converter/pkg/conformance"
+	"vietnamese-converter/pkg/converter"
+	"vietnamese-converter/pkg/turbo"
+)
+
+func TestEnginesAgree(t *testing.T) {
+	cfg := conformance.DefaultConfig("../../random_numbers_with_vietnamese.txt")
+	if testing.Short() {
+		cfg.ExhaustiveUpTo = 10000
+		cfg.Samples = 1900
+	}
+
+	report, err := conformance.Run(conformance.Engines(), cfg)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if !report.OK() {
+		var sb strings.Builder
+		report.WriteTo(&sb)
+		t.Errorf("engines diverge:\n%s", sb.String())
+	}
+	if len(report.Engines) < 3 {
+		t.Errorf("got engines %v, want the three built-in engines", report.Engines)
+	}
+}
+
+// lossyConverter drops "không trăm" from inner groups, the bug the harness must catch
+type lossyConverter struct {
+	converter.NumberConverter
+}
+
+func (c lossyConverter) Convert(number int64) (string, error) {
+	text, err := c.NumberConverter.Convert(number)
+	return strings.Replace(text, " không trăm", "", 1), err
+}
+
+func TestRunReportsDivergence(t *testing.T) {
+	engines := []conformance.Engine{
+		{Name: "reference", Converter: converter.NewVietnameseConverter()},
+		{Name: "lossy", Converter: lossyConverter{converter.NewVietnameseConverter()}},
+	}
+	cfg := conformance.Config{ExhaustiveUpTo: 2000, MaxDivergences: 3}
+
+	report, err := conformance.Run(engines, cfg)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if report.OK() || len(report.Divergences) != 3 {
+		t.Fatalf("got %d divergences (%d kept), want more than 3 with 3 kept", report.Diverged, len(report.Divergences))
+	}
+
+	first := report.Divergences[0]
+	if first.Number != 1001 || first.Pattern != "x.00x" || first.Source != conformance.SourceExhaustive {
+		t.Errorf("first divergence = %d [%s] %s, want 1001 [x.00x] exhaustive", first.Number, first.Pattern, first.Source)
+	}
+	if first.Readings["reference"] != "một nghìn không trăm một đồng" || first.Readings["lossy"] != "một nghìn một đồng" {
+		t.Errorf("readings = %v", first.Readings)
+	}
+}
+
+func TestRunGoldenMismatch(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("21 hai mươi một đồng\n")
+	f.Close()
+
+	engines := []conformance.Engine{{Name: "turbo", Converter: converter.NewTurboConverter()}}
+	report, err := conformance.Run(engines, conformance.Config{ExhaustiveUpTo: -1, GoldenFile: f.Name(), MaxDivergences: 1})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if report.Diverged != 1 || report.Divergences[0].Expected != "hai mươi một đồng" {
+		t.Errorf("got %+v, want one golden divergence", report)
+	}
+}
+
+func TestEnginesAgreeOnZeroPolicies(t *testing.T) {
+	policies := []converter.ZeroPolicy{
+		{OmitZeroHundreds: true},
+		{OddZeroAlways: true},
+		{OmitZeroHundreds: true, OddZeroAlways: true},
+		{ReadEmptyGroups: true},
+		{OmitZeroHundreds: true, OddZeroAlways: true, ReadEmptyGroups: true},
+	}
+	for _, policy := range policies {
+		engines := []conformance.Engine{
+			{Name: "vietnamese", Converter: converter.NewVietnameseConverter(converter.WithZeroPolicy(policy))},
+			{Name: "turbo", Converter: converter.NewTurboConverter(converter.WithZeroPolicy(policy))},
+			{Name: "zero_alloc", Converter: turbo.NewZeroAllocConverter(converter.WithZeroPolicy(policy))},
+		}
+		report, err := conformance.Run(engines, conformance.Config{ExhaustiveUpTo: 20000, Samples: 1900, Seed: 1, MaxDivergences: 5})
+		if err != nil {
+			t.Fatalf("Run: %v", err)
+		}
+		if !report.OK() {
+			var sb strings.Builder
+			report.WriteTo(&sb)
+			t.Errorf("engines diverge under %+v:\n%s", policy, sb.String())
+		}
+	}
+}
+
+func TestEnginesVerify(t *testing.T) {
+	tests := []struct {
+		digits string
+		text   string
+		status converter.VerifyStatus
+		groups int
+	}{
+		{"1005000", "Một triệu không trăm năm nghìn đồng chẵn./.", converter.VerifyExact, 0},
+		{"1105000", "một triệu một trăm linh năm ngàn đồng", converter.VerifyVariant, 0},
+		{"1005", "một nghìn không trăm lẻ năm đồng", converter.VerifyWording, 0},
+		{"1250005000", "một tỷ hai trăm năm mươi triệu không trăm năm mươi nghìn đồng", converter.VerifyMismatch, 1},
+		{"2000000", "hai nghìn đồng", converter.VerifyMismatch, 2},
+		{"1005000", "đồng", converter.VerifyUnreadable, 0},
+	}
+
+	for _, engine := range conformance.Engines() {
+		for _, tt := range tests {
+			got, err := converter.Verify(engine.Converter, tt.digits, tt.text)
+			if err != nil {
+				t.Errorf("%s: Verify(%s, %q) error: %v", engine.Name, tt.digits, tt.text, err)
+				continue
+			}
+			if got.Status != tt.status || len(got.Groups) != tt.groups {
+				t.Errorf("%s: Verify(%s, %q) = %s with %d groups, want %s with %d",
+					engine.Name, tt.digits, tt.text, got.Status, len(got.Groups), tt.status, tt.groups)
+			}
+		}
+	}
+}
+
+func TestGroupPattern(t *testing.T) {
+	tests := []struct {
+		number int64
+		want   string
+	}{
+		{0, "0"},
+		{120, "xx0"},
+		{1005000, "x.00x.000"},
+		{-25000, "-xx.000"},
+	}
+	for _, tt := range tests {
+		if got := conformance.GroupPattern(tt.number); got != tt.want {
+			t.Errorf("GroupPattern(%d) = %q, want %q", tt.number, got, tt.want)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if err := conformance.Register("", converter.NewTurboConverter()); err == nil {
+		t.Error("Register with empty name succeeded")
+	}
+	if err := conformance.Register("nil", nil); err == nil {
+		t.Error("Register with nil converter succeeded")
+	}
+}
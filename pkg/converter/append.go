@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"io"
+	"sync"
+)
+
+// AppendConverter is implemented by converters that write into caller-owned
+// buffers, for batch jobs that produce millions of rows
+type AppendConverter interface {
+	AppendConvert(dst []byte, number int64, currency string) ([]byte, error)
+	WriteConvert(w io.Writer, number int64, currency string) (int, error)
+}
+
+// writeBuffers recycles the buffers WriteConvert reads into
+var writeBuffers = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, maxUint64Text)
+		return &buf
+	},
+}
+
+// writeConverted implements WriteConvert on top of AppendConvert
+func writeConverted(ac AppendConverter, w io.Writer, number int64, currency string) (int, error) {
+	bufp := writeBuffers.Get().(*[]byte)
+	defer writeBuffers.Put(bufp)
+
+	buf, err := ac.AppendConvert((*bufp)[:0], number, currency)
+	*bufp = buf
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(buf)
+}
@@ -0,0 +1,53 @@
+package converter_test
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestAppendConvert(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	numbers := append([]int64{0, 1, 21, 1005, 1000000000, 9223372036854775807}, testNumbers...)
+	for i := 0; i < 1000; i++ {
+		numbers = append(numbers, rng.Int63n(1<<uint(rng.Intn(62)+1)))
+	}
+
+	for _, conv := range []converter.NumberConverter{converter.NewVietnameseConverter(), converter.NewTurboConverter()} {
+		ac := conv.(converter.AppendConverter)
+		for _, n := range numbers {
+			want, _ := conv.ConvertWithCurrency(n, "đồng")
+
+			got, err := ac.AppendConvert([]byte("> "), n, "đồng")
+			if err != nil || string(got) != "> "+want {
+				t.Errorf("AppendConvert(%d) = %q, %v, want %q", n, got, err, "> "+want)
+			}
+
+			var buf bytes.Buffer
+			written, err := ac.WriteConvert(&buf, n, "đồng")
+			if err != nil || buf.String() != want || written != len(want) {
+				t.Errorf("WriteConvert(%d) = %q (%d bytes), %v, want %q", n, buf.String(), written, err, want)
+			}
+		}
+
+		if got, err := ac.AppendConvert([]byte("x"), -1, "đồng"); err == nil || string(got) != "x" {
+			t.Errorf("AppendConvert(-1) = %q, %v, want the input buffer and an error", got, err)
+		}
+	}
+}
+
+func TestAppendConvertAllocations(t *testing.T) {
+	ac := converter.NewTurboConverter().(converter.AppendConverter)
+	buf := make([]byte, 0, 1024)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, n := range testNumbers {
+			buf, _ = ac.AppendConvert(buf[:0], n, "đồng")
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("AppendConvert allocated %.1f times per run, want 0", allocs)
+	}
+}
@@ -1,6 +1,7 @@
 package converter_test
 
 import (
+	"io"
 	"testing"
 
 	"vietnamese-converter/pkg/converter"
@@ -85,3 +86,34 @@ func BenchmarkParallelOptimized(b *testing.B) {
 		}
 	})
 }
+
+// BenchmarkAppendConvert measures the zero-allocation path used by batch exports
+func BenchmarkAppendConvert(b *testing.B) {
+	conv := converter.NewTurboConverter().(converter.AppendConverter)
+	buf := make([]byte, 0, 1024)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		num := testNumbers[i%len(testNumbers)]
+		var err error
+		buf, err = conv.AppendConvert(buf[:0], num, "đồng")
+		if err != nil {
+			b.Fatalf("Error converting %d: %v", num, err)
+		}
+	}
+}
+
+// BenchmarkWriteConvert measures streaming conversions into an io.Writer
+func BenchmarkWriteConvert(b *testing.B) {
+	conv := converter.NewTurboConverter().(converter.AppendConverter)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		num := testNumbers[i%len(testNumbers)]
+		if _, err := conv.WriteConvert(io.Discard, num, "đồng"); err != nil {
+			b.Fatalf("Error converting %d: %v", num, err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package converter
+
+import (
+	"math/big"
+)
+
+// BigConverter is implemented by converters that read integers beyond the int64 range.
+// Scales above "tỷ" chain recursively: nghìn tỷ, triệu tỷ, tỷ tỷ, nghìn tỷ tỷ, ...
+type BigConverter interface {
+	ConvertUint64(number uint64, currency string) (string, error)
+	ConvertBig(number *big.Int, currency string) (string, error)
+	ConvertDigits(digits string, currency string) (string, error)
+}
+
+// BillionsAfter returns how many "tỷ" follow the non-zero group at scaleIndex,
+// the group's place counted in groups of three digits from the units. Every
+// nine digits add one "tỷ", spoken only after the last non-zero group of its
+// nine-digit block: 1.020.000.000.000 reads "một nghìn không trăm hai mươi tỷ".
+// lower(k) returns the group at scale k, for k below scaleIndex.
+func BillionsAfter(scaleIndex int, lower func(k int) int) int {
+	if scaleIndex < 3 {
+		return 0
+	}
+	for k := scaleIndex - scaleIndex%3; k < scaleIndex; k++ {
+		if lower(k) != 0 {
+			return 0
+		}
+	}
+	return scaleIndex / 3
+}
+
+// splitDigitGroups splits a string of decimal digits into three-digit groups,
+// highest scale first. Leading zeros are dropped, so "0" yields no groups.
+func splitDigitGroups(digits string) ([]int, error) {
+	if digits == "" {
+		return nil, invalidInput(digits, "invalid number: empty input")
+	}
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return nil, invalidInput(digits, "invalid number %q: unexpected character %q", digits, digits[i])
+		}
+	}
+
+	digits = trimLeadingZeros(digits)
+	if digits == "0" {
+		return nil, nil
+	}
+
+	groups := make([]int, 0, (len(digits)+2)/3)
+	head := len(digits) % 3
+	if head == 0 {
+		head = 3
+	}
+	for start, end := 0, head; start < len(digits); start, end = end, end+3 {
+		group := 0
+		for i := start; i < end; i++ {
+			group = group*10 + int(digits[i]-'0')
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
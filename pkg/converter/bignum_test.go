@@ -0,0 +1,87 @@
+package converter_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestConvertDigits(t *testing.T) {
+	tests := []struct {
+		digits string
+		want   string
+	}{
+		{"0", "không"},
+		{"000123", "một trăm hai mươi ba"},
+		{"1000000000000", "một nghìn tỷ"},
+		{"1020000000000", "một nghìn không trăm hai mươi tỷ"},
+		{"1234567890123", "một nghìn hai trăm ba mươi tư tỷ năm trăm sáu mươi bảy triệu tám trăm chín mươi nghìn một trăm hai mươi ba"},
+		{"1000050000000000", "một triệu không trăm năm mươi tỷ"},
+		{"1000000000000000000", "một tỷ tỷ"},
+		{"18446744073709551615", "mười tám tỷ tỷ bốn trăm bốn mươi sáu triệu bảy trăm bốn mươi tư nghìn không trăm bảy mươi ba tỷ bảy trăm lẻ chín triệu năm trăm năm mươi mốt nghìn sáu trăm mười lăm"},
+		{"1000000000000000000011", "một nghìn tỷ tỷ không trăm mười một"},
+		{"1" + strings.Repeat("0", 27), "một tỷ tỷ tỷ"},
+		{"25" + strings.Repeat("0", 16) + "15", "hai mươi lăm tỷ tỷ không trăm mười lăm"},
+	}
+
+	engines := map[string]converter.NumberConverter{
+		"original": converter.NewVietnameseConverter(),
+		"turbo":    converter.NewTurboConverter(),
+	}
+
+	for name, engine := range engines {
+		bc, ok := engine.(converter.BigConverter)
+		if !ok {
+			t.Fatalf("%s converter does not implement BigConverter", name)
+		}
+		for _, tt := range tests {
+			got, err := bc.ConvertDigits(tt.digits, "")
+			if err != nil {
+				t.Errorf("%s: ConvertDigits(%s) returned error: %v", name, tt.digits, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%s: ConvertDigits(%s) = %q, want %q", name, tt.digits, got, tt.want)
+			}
+
+			n, _ := new(big.Int).SetString(tt.digits, 10)
+			if viaBig, err := bc.ConvertBig(n, ""); err != nil || viaBig != got {
+				t.Errorf("%s: ConvertBig(%s) = %q, %v; want %q", name, tt.digits, viaBig, err, got)
+			}
+			if n.IsUint64() {
+				if viaUint, err := bc.ConvertUint64(n.Uint64(), ""); err != nil || viaUint != got {
+					t.Errorf("%s: ConvertUint64(%s) = %q, %v; want %q", name, tt.digits, viaUint, err, got)
+				}
+			}
+		}
+
+		for _, digits := range []string{"", "12a", "-5"} {
+			if _, err := bc.ConvertDigits(digits, ""); err == nil {
+				t.Errorf("%s: ConvertDigits(%q) expected error", name, digits)
+			}
+		}
+	}
+}
+
+func TestBillionsAfter(t *testing.T) {
+	tests := []struct {
+		groups []int // lowest scale first
+		scale  int
+		want   int
+	}{
+		{[]int{5, 0, 2}, 2, 0},
+		{[]int{0, 0, 0, 1}, 3, 1},
+		{[]int{0, 0, 0, 20, 1}, 4, 0}, // 1.020.000.000.000: "một nghìn không trăm hai mươi tỷ"
+		{[]int{0, 0, 0, 20, 1}, 3, 1},
+		{[]int{0, 0, 0, 0, 0, 0, 7}, 6, 2},    // "bảy tỷ tỷ"
+		{[]int{0, 0, 0, 0, 0, 0, 5, 7}, 7, 0}, // "bảy nghìn không trăm năm tỷ tỷ"
+	}
+	for _, tt := range tests {
+		got := converter.BillionsAfter(tt.scale, func(k int) int { return tt.groups[k] })
+		if got != tt.want {
+			t.Errorf("BillionsAfter(%d, %v) = %d, want %d", tt.scale, tt.groups, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package converter
+
+// Style selects how formally amounts are read
+type Style int
+
+const (
+	// StyleFormal reads every group in full, as on invoices: "một triệu năm trăm nghìn" (default)
+	StyleFormal Style = iota
+	// StyleColloquial reads amounts the way people say them, for chatbots and voice:
+	//
+	//   - "mươi" is dropped before a units digit: 21 is "hai mốt", 35 "ba lăm", 24 "hai tư"
+	//   - a last group of only hundreds right after a non-zero group below a trillion
+	//     loses its scale: 1.200.000 is "một triệu hai", 3.000.500 stays formal
+	//   - a last group of hundreds and tens only reads the tens digit after "trăm":
+	//     120 is "một trăm hai", 1.110.000 "một triệu một trăm mốt nghìn"
+	//   - in both shortened forms 1 is "mốt", 4 "tư" and 5 "rưỡi":
+	//     1.500.000 is "một triệu rưỡi", 150 "một trăm rưỡi"; since no scale word
+	//     may follow "rưỡi", 1.250.000 stays "một triệu hai trăm năm mươi nghìn"
+	//
+	// It applies to amounts, percentages and measures; ordinals, dates, times
+	// and fractions keep the formal reading.
+	StyleColloquial
+)
+
+// WithStyle selects formal or colloquial readings of amounts
+func WithStyle(s Style) Option {
+	return func(o *options) {
+		o.style = s
+	}
+}
+
+// halfWord reads a 5 that stands for half of the scale before it: "một triệu rưỡi"
+const halfWord = "rưỡi"
+
+// shortTail names the colloquial shortening of an amount's last non-zero group
+type shortTail int
+
+const (
+	tailNone         shortTail = iota
+	tailAfterScale             // d00 after a non-zero group: "một triệu hai", the group's scale dropped
+	tailAfterHundred           // Xd0: "một trăm hai"
+)
+
+// colloquialTail finds how the colloquial style shortens an amount. group(k)
+// returns the group at scale k, 0 being the units, for k below count. It
+// returns the scale of the last non-zero group, the shortening and the digit
+// read in short form.
+func colloquialTail(count int, group func(k int) int) (at int, tail shortTail, digit int) {
+	at = 0
+	for at < count && group(at) == 0 {
+		at++
+	}
+	if at == count {
+		return 0, tailNone, 0
+	}
+
+	g := group(at)
+	switch {
+	// Above the "tỷ" group the scale before would be ambiguous: "một nghìn hai" for 1.200 tỷ
+	case g%100 == 0 && at+1 < count && at < 3 && group(at+1) != 0:
+		return at, tailAfterScale, g / 100
+	// "rưỡi" halves the word before it, so it cannot stand before a scale word:
+	// 250.000 is "hai trăm năm mươi nghìn", never "hai trăm rưỡi nghìn"
+	case g >= 100 && g%10 == 0 && g%100 != 0 && (at == 0 || g/10%10 != 5):
+		return at, tailAfterHundred, g / 10 % 10
+	}
+	return at, tailNone, 0
+}
+
+// shortWord returns the word for digit in a shortened tail
+func (lex Lexicon) shortWord(digit int) string {
+	switch digit {
+	case 1:
+		return lex.OneAfterTens
+	case 4:
+		return lex.FourAfterTens
+	case 5:
+		return halfWord
+	}
+	return lex.Digits[digit]
+}
+
+// formal returns o with the formal style, for readings the colloquial style leaves alone
+func (o options) formal() options {
+	o.style = StyleFormal
+	return o
+}
@@ -0,0 +1,120 @@
+package converter_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestColloquialStyle(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{21, "hai mốt"},
+		{35, "ba lăm"},
+		{24, "hai tư"},
+		{20, "hai mươi"},
+		{15, "mười lăm"},
+		{120, "một trăm hai"},
+		{150, "một trăm rưỡi"},
+		{110, "một trăm mốt"},
+		{105, "một trăm lẻ năm"},
+		{1_200, "một nghìn hai"},
+		{1_200_000, "một triệu hai"},
+		{1_500_000, "một triệu rưỡi"},
+		{2_400_000_000, "hai tỷ tư"},
+		{1_110_000, "một triệu một trăm mốt nghìn"},
+		{1_250_000, "một triệu hai trăm năm mươi nghìn"},
+		{150_000, "một trăm năm mươi nghìn"},
+		{140_000, "một trăm tư nghìn"},
+		{2_000_150, "hai triệu một trăm rưỡi"},
+		{1_021_000, "một triệu không trăm hai mốt nghìn"},
+		{500_000, "năm trăm nghìn"},
+		{3_000_500, "ba triệu năm trăm"},
+		// Below the "tỷ" group the scale is unambiguous; above it the group is read in full
+		{1_200_000_000_000, "một nghìn hai trăm tỷ"},
+	}
+
+	for name, newConv := range map[string]func(...converter.Option) converter.NumberConverter{
+		"vietnamese": converter.NewVietnameseConverter,
+		"turbo":      converter.NewTurboConverter,
+	} {
+		conv := newConv(converter.WithStyle(converter.StyleColloquial))
+		for _, tt := range tests {
+			got, err := conv.ConvertWithCurrency(tt.n, "")
+			if err != nil || got != tt.want {
+				t.Errorf("%s: ConvertWithCurrency(%d) = %q, %v, want %q", name, tt.n, got, err, tt.want)
+			}
+			// The colloquial reading parses back to the number
+			if amount, err := converter.ParseAmount(got, converter.WithStyle(converter.StyleColloquial)); err != nil || !amount.Value.IsInt64() || amount.Value.Int64() != tt.n {
+				t.Errorf("%s: ParseAmount(%q) = %v, %v, want %d", name, got, amount.Value, err, tt.n)
+			}
+		}
+
+		// Ordinals and dates stay formal
+		if got, _ := conv.(converter.OrdinalConverter).ConvertOrdinal(21); got != "thứ hai mươi mốt" {
+			t.Errorf("%s: ConvertOrdinal(21) = %q", name, got)
+		}
+		date := time.Date(1500, 1, 21, 0, 0, 0, 0, time.UTC)
+		if got, _ := conv.(converter.DateTimeConverter).ConvertDate(date, converter.DateOptions{}); got != "ngày hai mươi mốt tháng một năm một nghìn năm trăm" {
+			t.Errorf("%s: ConvertDate = %q", name, got)
+		}
+	}
+}
+
+func TestColloquialRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	numbers := []int64{21, 110, 150, 1_500_000, 2_400_000_000, 1_002_300, 120_000, math.MaxInt64}
+	for i := 0; i < 5000; i++ {
+		n := rng.Int63n(1 << uint(rng.Intn(62)+1))
+		// Round to a few significant digits, where the shortened forms appear
+		for m := int64(1); m < n/1000; m *= 10 {
+			n -= n % (m * 10)
+		}
+		numbers = append(numbers, n)
+	}
+
+	for name, newConv := range map[string]func(...converter.Option) converter.NumberConverter{
+		"vietnamese": converter.NewVietnameseConverter,
+		"turbo":      converter.NewTurboConverter,
+	} {
+		conv := newConv(converter.WithStyle(converter.StyleColloquial))
+		for _, n := range numbers {
+			text, err := conv.Convert(n)
+			if err != nil {
+				t.Fatalf("%s: Convert(%d): %v", name, n, err)
+			}
+			got, err := converter.Parse(text)
+			if err != nil || got != n {
+				t.Errorf("%s: Parse(%q) = %d, %v, want %d", name, text, got, err, n)
+			}
+		}
+	}
+}
+
+func TestColloquialExplain(t *testing.T) {
+	conv := converter.NewVietnameseConverter(converter.WithStyle(converter.StyleColloquial))
+	explanation, err := conv.(converter.Explainer).Explain("1500000", "đồng")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []converter.Token{
+		{Word: "một", Kind: converter.TokenUnit, Start: 0, End: 1},
+		{Word: "triệu", Kind: converter.TokenScale, Start: 0, End: 1},
+		{Word: "rưỡi", Kind: converter.TokenUnit, Start: 1, End: 2},
+		{Word: "đồng", Kind: converter.TokenCurrency, Start: 0, End: 7},
+	}
+	if len(explanation.Tokens) != len(want) {
+		t.Fatalf("Explain(1500000) = %+v, want %+v", explanation.Tokens, want)
+	}
+	for i := range want {
+		if explanation.Tokens[i] != want[i] {
+			t.Errorf("token %d = %+v, want %+v", i, explanation.Tokens[i], want[i])
+		}
+	}
+}
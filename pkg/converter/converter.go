@@ -4,6 +4,6 @@ package converter
 
 // NewConverter creates and returns the optimal Vietnamese number converter implementation
 // This is the main entry point for applications using this library
-func NewConverter() NumberConverter {
-	return NewTurboConverter()
+func NewConverter(opts ...Option) NumberConverter {
+	return NewTurboConverter(opts...)
 }
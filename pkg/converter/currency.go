@@ -0,0 +1,133 @@
+package converter
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Currency describes how amounts in one ISO 4217 currency are read
+type Currency struct {
+	Code        string // ISO 4217 code, e.g. "USD"
+	Major       string // major unit word, e.g. "đô la Mỹ"
+	Minor       string // minor unit word, e.g. "xen"; empty when MinorDigits is 0
+	MinorDigits int    // minor digits per major unit, e.g. 2 for cents
+
+	// English names the units for English output; empty names read as the code
+	English CurrencyNames
+}
+
+// CurrencyNames holds unit words for a language that inflects them for number
+type CurrencyNames struct {
+	Major       string // "dollar"
+	MajorPlural string // "dollars"
+	Minor       string // "cent"
+	MinorPlural string // "cents"
+}
+
+// DecimalOptions returns base with the minor unit and digits of the currency
+func (c Currency) DecimalOptions(base DecimalOptions) DecimalOptions {
+	base.MinorUnit = c.Minor
+	base.MinorDigits = c.MinorDigits
+	return base
+}
+
+var (
+	currencyMu sync.RWMutex
+	currencies = map[string]Currency{}
+)
+
+func init() {
+	for _, c := range []Currency{
+		{Code: "VND", Major: "đồng", Minor: "xu", MinorDigits: 2,
+			English: CurrencyNames{"dong", "dong", "xu", "xu"}},
+		{Code: "USD", Major: "đô la Mỹ", Minor: "xen", MinorDigits: 2,
+			English: CurrencyNames{"US dollar", "US dollars", "cent", "cents"}},
+		{Code: "EUR", Major: "euro", Minor: "xen", MinorDigits: 2,
+			English: CurrencyNames{"euro", "euros", "cent", "cents"}},
+		{Code: "GBP", Major: "bảng Anh", Minor: "xu", MinorDigits: 2,
+			English: CurrencyNames{"pound sterling", "pounds sterling", "penny", "pence"}},
+		{Code: "JPY", Major: "yên Nhật", MinorDigits: 0,
+			English: CurrencyNames{Major: "yen", MajorPlural: "yen"}},
+		{Code: "CNY", Major: "nhân dân tệ", Minor: "xu", MinorDigits: 2,
+			English: CurrencyNames{"yuan", "yuan", "fen", "fen"}},
+		{Code: "KRW", Major: "won Hàn Quốc", MinorDigits: 0,
+			English: CurrencyNames{Major: "won", MajorPlural: "won"}},
+		{Code: "THB", Major: "bạt Thái", Minor: "xa tăng", MinorDigits: 2,
+			English: CurrencyNames{"baht", "baht", "satang", "satang"}},
+		{Code: "SGD", Major: "đô la Singapore", Minor: "xen", MinorDigits: 2,
+			English: CurrencyNames{"Singapore dollar", "Singapore dollars", "cent", "cents"}},
+		{Code: "AUD", Major: "đô la Úc", Minor: "xen", MinorDigits: 2,
+			English: CurrencyNames{"Australian dollar", "Australian dollars", "cent", "cents"}},
+		{Code: "CAD", Major: "đô la Canada", Minor: "xen", MinorDigits: 2,
+			English: CurrencyNames{"Canadian dollar", "Canadian dollars", "cent", "cents"}},
+		{Code: "HKD", Major: "đô la Hồng Kông", Minor: "xen", MinorDigits: 2,
+			English: CurrencyNames{"Hong Kong dollar", "Hong Kong dollars", "cent", "cents"}},
+		{Code: "TWD", Major: "Đài tệ", Minor: "xu", MinorDigits: 2,
+			English: CurrencyNames{"New Taiwan dollar", "New Taiwan dollars", "cent", "cents"}},
+		{Code: "LAK", Major: "kíp Lào", Minor: "át", MinorDigits: 2,
+			English: CurrencyNames{"kip", "kip", "att", "att"}},
+		{Code: "KHR", Major: "riel Campuchia", Minor: "xen", MinorDigits: 2,
+			English: CurrencyNames{"riel", "riels", "sen", "sen"}},
+	} {
+		currencies[c.Code] = c
+	}
+}
+
+// RegisterCurrency adds a currency to the registry, replacing any currency with the same code
+func RegisterCurrency(c Currency) error {
+	c.Code = strings.ToUpper(strings.TrimSpace(c.Code))
+	if len(c.Code) != 3 {
+		return invalidInput(c.Code, "invalid currency code %q: expected three letters", c.Code)
+	}
+	for _, r := range c.Code {
+		if r < 'A' || r > 'Z' {
+			return invalidInput(c.Code, "invalid currency code %q: expected three letters", c.Code)
+		}
+	}
+	if c.Major == "" {
+		return invalidInput(c.Code, "currency %s: missing major unit word", c.Code)
+	}
+	if c.MinorDigits < 0 || c.MinorDigits > 4 {
+		return invalidInput(c.Code, "currency %s: minor digits must be between 0 and 4", c.Code)
+	}
+	if c.MinorDigits > 0 && c.Minor == "" {
+		return invalidInput(c.Code, "currency %s: missing minor unit word", c.Code)
+	}
+
+	currencyMu.Lock()
+	currencies[c.Code] = c
+	currencyMu.Unlock()
+	return nil
+}
+
+// LookupCurrency finds a registered currency by ISO 4217 code, ignoring case
+func LookupCurrency(code string) (Currency, bool) {
+	currencyMu.RLock()
+	c, ok := currencies[strings.ToUpper(code)]
+	currencyMu.RUnlock()
+	return c, ok
+}
+
+// Currencies lists the registered currencies sorted by code
+func Currencies() []Currency {
+	currencyMu.RLock()
+	list := make([]Currency, 0, len(currencies))
+	for _, c := range currencies {
+		list = append(list, c)
+	}
+	currencyMu.RUnlock()
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+// ConvertCurrency reads an amount in a registered currency, taking the unit
+// words and minor digits from the registry and everything else from opts
+func ConvertCurrency(dc DecimalConverter, amount Decimal, code string, opts DecimalOptions) (string, error) {
+	c, ok := LookupCurrency(code)
+	if !ok {
+		return "", invalidInput(code, "unknown currency %q", code)
+	}
+	return dc.ConvertDecimal(amount, c.Major, c.DecimalOptions(opts))
+}
@@ -0,0 +1,67 @@
+package converter_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestConvertCurrency(t *testing.T) {
+	tests := []struct {
+		amount string
+		code   string
+		want   string
+	}{
+		{"12.5", "USD", "mười hai đô la Mỹ năm mươi xen"},
+		{"12.5", "usd", "mười hai đô la Mỹ năm mươi xen"},
+		{"0,99", "EUR", "chín mươi chín xen"},
+		{"1000", "JPY", "một nghìn yên Nhật"},
+		{"1.234.567,6", "VND", "một triệu hai trăm ba mươi tư nghìn năm trăm sáu mươi bảy đồng sáu mươi xu"},
+		{"3.05", "THB", "ba bạt Thái năm xa tăng"},
+	}
+
+	for _, conv := range []converter.NumberConverter{converter.NewVietnameseConverter(), converter.NewTurboConverter()} {
+		dc := conv.(converter.DecimalConverter)
+		for _, tt := range tests {
+			amount, err := converter.ParseDecimal(tt.amount)
+			if err != nil {
+				t.Fatalf("ParseDecimal(%q): %v", tt.amount, err)
+			}
+			got, err := converter.ConvertCurrency(dc, amount, tt.code, converter.DefaultDecimalOptions())
+			if err != nil {
+				t.Errorf("ConvertCurrency(%s %s) returned error: %v", tt.amount, tt.code, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("ConvertCurrency(%s %s) = %q, want %q", tt.amount, tt.code, got, tt.want)
+			}
+		}
+	}
+
+	if _, err := converter.ConvertCurrency(converter.NewTurboConverter().(converter.DecimalConverter), converter.Decimal{}, "XXX", converter.DefaultDecimalOptions()); err == nil {
+		t.Errorf("ConvertCurrency with unknown code should fail")
+	}
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	if err := converter.RegisterCurrency(converter.Currency{Code: "mmk", Major: "kyat Myanmar", Minor: "pya", MinorDigits: 2}); err != nil {
+		t.Fatalf("RegisterCurrency returned error: %v", err)
+	}
+	c, ok := converter.LookupCurrency("MMK")
+	if !ok || c.Major != "kyat Myanmar" || c.MinorDigits != 2 {
+		t.Errorf("LookupCurrency(MMK) = %+v, %v", c, ok)
+	}
+
+	invalid := []converter.Currency{
+		{Code: "US", Major: "đô la"},
+		{Code: "U5D", Major: "đô la"},
+		{Code: "ABC"},
+		{Code: "ABC", Major: "abc", MinorDigits: 2},
+		{Code: "ABC", Major: "abc", Minor: "x", MinorDigits: -1},
+	}
+	for _, c := range invalid {
+		if err := converter.RegisterCurrency(c); err == nil {
+			t.Errorf("RegisterCurrency(%+v) should fail", c)
+		}
+	}
+}
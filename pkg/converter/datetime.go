@@ -0,0 +1,140 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateOptions configures ConvertDate
+type DateOptions struct {
+	// LunarMonthNames reads month 1 as "tháng giêng" and month 12 as "tháng chạp"
+	LunarMonthNames bool
+	// Mong reads days 1 to 10 with "mồng": "ngày mồng năm tháng sáu"
+	Mong bool
+	// OmitYear reads only the day and month: "ngày hai tháng chín"
+	OmitYear bool
+}
+
+// DateTimeConverter is implemented by converters that read calendar dates and clock times
+type DateTimeConverter interface {
+	ConvertDate(date time.Time, opts DateOptions) (string, error)
+	ConvertTime(hour, minute, second int) (string, error)
+}
+
+// dateLayouts are the date notations accepted by ParseDate, day first as written in Vietnam
+var dateLayouts = []string{"2/1/2006", "2-1-2006", "2.1.2006", "2006-01-02"}
+
+// ParseDate parses a date written as 15/04/2024, 15-04-2024, 15.04.2024 or 2024-04-15
+func ParseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, invalidInput(s, "invalid date %q: expected dd/mm/yyyy", s)
+}
+
+// ParseClock parses a time of day written as 08:05 or 08:05:30
+func ParseClock(s string) (hour, minute, second int, err error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, 0, invalidInput(s, "invalid time %q: expected hh:mm or hh:mm:ss", s)
+	}
+
+	values := make([]int, 3)
+	for i, part := range parts {
+		if len(part) == 0 || len(part) > 2 {
+			return 0, 0, 0, invalidInput(s, "invalid time %q: expected hh:mm or hh:mm:ss", s)
+		}
+		if values[i], err = strconv.Atoi(part); err != nil || values[i] < 0 {
+			return 0, 0, 0, invalidInput(s, "invalid time %q: expected hh:mm or hh:mm:ss", s)
+		}
+	}
+	if err := validateClock(values[0], values[1], values[2]); err != nil {
+		return 0, 0, 0, err
+	}
+	return values[0], values[1], values[2], nil
+}
+
+func validateClock(hour, minute, second int) error {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 || second < 0 || second > 59 {
+		return &Error{Kind: ErrOutOfRange, Msg: fmt.Sprintf("invalid time %02d:%02d:%02d", hour, minute, second)}
+	}
+	return nil
+}
+
+// convertDate implements ConvertDate on top of a converter's cardinal reading:
+// 15/04/2024 reads "ngày mười lăm tháng tư năm hai nghìn không trăm hai mươi tư"
+func convertDate(nc NumberConverter, date time.Time, opts DateOptions) (string, error) {
+	if date.Year() < 1 {
+		return "", &Error{Kind: ErrOutOfRange, Limit: "1", Msg: fmt.Sprintf("invalid date: year %d before 1", date.Year())}
+	}
+
+	day, err := nc.ConvertWithCurrency(int64(date.Day()), "")
+	if err != nil {
+		return "", err
+	}
+	if opts.Mong && date.Day() <= 10 {
+		day = "mồng " + day
+	}
+
+	month, err := monthName(nc, date.Month(), opts)
+	if err != nil {
+		return "", err
+	}
+
+	text := "ngày " + day + " tháng " + month
+	if opts.OmitYear {
+		return text, nil
+	}
+
+	year, err := nc.ConvertWithCurrency(int64(date.Year()), "")
+	if err != nil {
+		return "", err
+	}
+	return text + " năm " + year, nil
+}
+
+// monthName reads the month number. Month 4 is always "tư", never "bốn".
+func monthName(nc NumberConverter, month time.Month, opts DateOptions) (string, error) {
+	switch {
+	case month == time.April:
+		return "tư", nil
+	case month == time.January && opts.LunarMonthNames:
+		return "giêng", nil
+	case month == time.December && opts.LunarMonthNames:
+		return "chạp", nil
+	}
+	return nc.ConvertWithCurrency(int64(month), "")
+}
+
+// convertTime implements ConvertTime: 08:05 reads "tám giờ năm phút".
+// Zero minutes and seconds are left out, so 08:00 is just "tám giờ".
+func convertTime(nc NumberConverter, hour, minute, second int) (string, error) {
+	if err := validateClock(hour, minute, second); err != nil {
+		return "", err
+	}
+
+	text, err := nc.ConvertWithCurrency(int64(hour), "giờ")
+	if err != nil {
+		return "", err
+	}
+	if minute > 0 || second > 0 {
+		minutes, err := nc.ConvertWithCurrency(int64(minute), "phút")
+		if err != nil {
+			return "", err
+		}
+		text += " " + minutes
+	}
+	if second > 0 {
+		seconds, err := nc.ConvertWithCurrency(int64(second), "giây")
+		if err != nil {
+			return "", err
+		}
+		text += " " + seconds
+	}
+	return text, nil
+}
@@ -0,0 +1,86 @@
+package converter_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestConvertDate(t *testing.T) {
+	tests := []struct {
+		date string
+		opts converter.DateOptions
+		want string
+	}{
+		{"15/04/2024", converter.DateOptions{}, "ngày mười lăm tháng tư năm hai nghìn không trăm hai mươi tư"},
+		{"2024-04-15", converter.DateOptions{}, "ngày mười lăm tháng tư năm hai nghìn không trăm hai mươi tư"},
+		{"1.1.2000", converter.DateOptions{}, "ngày một tháng một năm hai nghìn"},
+		{"1/1/2000", converter.DateOptions{LunarMonthNames: true}, "ngày một tháng giêng năm hai nghìn"},
+		{"05-06-1999", converter.DateOptions{Mong: true}, "ngày mồng năm tháng sáu năm một nghìn chín trăm chín mươi chín"},
+		{"31/12/2021", converter.DateOptions{LunarMonthNames: true, Mong: true}, "ngày ba mươi mốt tháng chạp năm hai nghìn không trăm hai mươi mốt"},
+		{"24/11/2025", converter.DateOptions{}, "ngày hai mươi tư tháng mười một năm hai nghìn không trăm hai mươi lăm"},
+		{"02/09/1945", converter.DateOptions{OmitYear: true}, "ngày hai tháng chín"},
+	}
+
+	for _, conv := range []converter.NumberConverter{converter.NewVietnameseConverter(), converter.NewTurboConverter()} {
+		dc := conv.(converter.DateTimeConverter)
+		for _, tt := range tests {
+			date, err := converter.ParseDate(tt.date)
+			if err != nil {
+				t.Fatalf("ParseDate(%q): %v", tt.date, err)
+			}
+			got, err := dc.ConvertDate(date, tt.opts)
+			if err != nil {
+				t.Errorf("ConvertDate(%s) returned error: %v", tt.date, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("ConvertDate(%s) = %q, want %q", tt.date, got, tt.want)
+			}
+		}
+	}
+
+	for _, s := range []string{"", "32/01/2024", "29/02/2023", "15/13/2024", "April 15"} {
+		if _, err := converter.ParseDate(s); err == nil {
+			t.Errorf("ParseDate(%q) should fail", s)
+		}
+	}
+}
+
+func TestConvertTime(t *testing.T) {
+	tests := []struct {
+		clock string
+		want  string
+	}{
+		{"08:05", "tám giờ năm phút"},
+		{"8:00", "tám giờ"},
+		{"00:00", "không giờ"},
+		{"23:59:59", "hai mươi ba giờ năm mươi chín phút năm mươi chín giây"},
+		{"14:00:15", "mười bốn giờ không phút mười lăm giây"},
+		{"21:41", "hai mươi mốt giờ bốn mươi mốt phút"},
+	}
+
+	for _, conv := range []converter.NumberConverter{converter.NewVietnameseConverter(), converter.NewTurboConverter()} {
+		dc := conv.(converter.DateTimeConverter)
+		for _, tt := range tests {
+			hour, minute, second, err := converter.ParseClock(tt.clock)
+			if err != nil {
+				t.Fatalf("ParseClock(%q): %v", tt.clock, err)
+			}
+			got, err := dc.ConvertTime(hour, minute, second)
+			if err != nil {
+				t.Errorf("ConvertTime(%s) returned error: %v", tt.clock, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("ConvertTime(%s) = %q, want %q", tt.clock, got, tt.want)
+			}
+		}
+	}
+
+	for _, s := range []string{"", "8", "24:00", "12:60", "12:30:60", "1:2:3:4", "-1:30", "123:00"} {
+		if _, _, _, err := converter.ParseClock(s); err == nil {
+			t.Errorf("ParseClock(%q) should fail", s)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package converter
+
+import "strings"
+
+// FractionMode selects how the digits after the decimal separator are read
+type FractionMode int
+
+const (
+	// FractionMinorUnit reads the fraction as a currency minor unit: "năm mươi xu"
+	FractionMinorUnit FractionMode = iota
+	// FractionDigits reads the fraction mathematically: "phẩy năm"
+	FractionDigits
+)
+
+// RoundingMode controls how a fraction is shortened to the digits that are read
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds ties away from zero (0,125 -> 0,13)
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds ties to the nearest even digit (0,125 -> 0,12)
+	RoundHalfEven
+	// RoundDown drops the extra digits (0,129 -> 0,12)
+	RoundDown
+)
+
+// DecimalOptions configures ConvertDecimal.
+// Start from DefaultDecimalOptions: a zero MinorDigits means the currency has no minor unit.
+type DecimalOptions struct {
+	Fraction    FractionMode
+	MinorUnit   string // word for one minor unit, e.g. "xu"
+	MinorDigits int    // minor digits per major unit, e.g. 2 for xu
+	Scale       int    // fractional digits kept in FractionDigits mode, 0 keeps all
+	Rounding    RoundingMode
+	Sign        SignOptions // how negative amounts are read, rejected by default
+}
+
+// DefaultDecimalOptions reads fractions as hundredths of a đồng ("xu"), rounding half up
+func DefaultDecimalOptions() DecimalOptions {
+	return DecimalOptions{
+		Fraction:    FractionMinorUnit,
+		MinorUnit:   "xu",
+		MinorDigits: 2,
+		Rounding:    RoundHalfUp,
+	}
+}
+
+// DecimalConverter is implemented by converters that can read exact decimal amounts
+type DecimalConverter interface {
+	ConvertDecimal(amount Decimal, currency string, opts DecimalOptions) (string, error)
+}
+
+// Decimal is an exact decimal amount kept as digit strings,
+// so values like 1.234.567,50 never pass through float64
+type Decimal struct {
+	neg      bool
+	integer  string // digits without leading zeros, "0" for zero
+	fraction string // digits after the decimal separator, may be empty
+}
+
+// ParseDecimal parses an amount written either the Vietnamese way (1.234.567,50)
+// or the international way (1,234,567.50). When both '.' and ',' appear the last
+// one is the decimal separator; a separator repeated more than once groups
+// thousands. A single separator on its own groups thousands when it can, that
+// is when exactly three digits follow it and the integer part has one to three
+// digits without a leading zero: 500.000 and 1,000 are thousands, 12.5 and
+// 0,125 decimals.
+func ParseDecimal(s string) (Decimal, error) {
+	var d Decimal
+
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "-") {
+		d.neg = true
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	if s == "" {
+		return Decimal{}, invalidInput(s, "invalid decimal: empty input")
+	}
+
+	for i := 0; i < len(s); i++ {
+		if (s[i] < '0' || s[i] > '9') && s[i] != '.' && s[i] != ',' {
+			return Decimal{}, invalidInput(s, "invalid decimal %q: unexpected character %q", s, s[i])
+		}
+	}
+
+	dots := strings.Count(s, ".")
+	commas := strings.Count(s, ",")
+
+	var decimalSep, groupSep byte
+	switch {
+	case dots > 0 && commas > 0:
+		if strings.LastIndexByte(s, '.') > strings.LastIndexByte(s, ',') {
+			decimalSep, groupSep = '.', ','
+		} else {
+			decimalSep, groupSep = ',', '.'
+		}
+	case dots == 1 && isThousandsGroup(s, '.'):
+		groupSep = '.'
+	case commas == 1 && isThousandsGroup(s, ','):
+		groupSep = ','
+	case dots == 1:
+		decimalSep = '.'
+	case commas == 1:
+		decimalSep = ','
+	case dots > 1:
+		groupSep = '.'
+	case commas > 1:
+		groupSep = ','
+	}
+
+	intText, fracText := s, ""
+	if decimalSep != 0 {
+		if strings.Count(s, string(decimalSep)) > 1 {
+			return Decimal{}, invalidInput(s, "invalid decimal %q: more than one decimal separator", s)
+		}
+		i := strings.IndexByte(s, decimalSep)
+		intText, fracText = s[:i], s[i+1:]
+		if fracText == "" || strings.ContainsAny(fracText, ".,") {
+			return Decimal{}, invalidInput(s, "invalid decimal %q: malformed fraction", s)
+		}
+	}
+
+	if groupSep != 0 {
+		groups := strings.Split(intText, string(groupSep))
+		for i, g := range groups {
+			if g == "" || len(g) > 3 || (i > 0 && len(g) != 3) {
+				return Decimal{}, invalidInput(s, "invalid decimal %q: misplaced thousands separator", s)
+			}
+		}
+		intText = strings.Join(groups, "")
+	}
+	if intText == "" {
+		return Decimal{}, invalidInput(s, "invalid decimal %q: missing integer part", s)
+	}
+
+	d.integer = trimLeadingZeros(intText)
+	d.fraction = fracText
+	return d, nil
+}
+
+// String returns the canonical form of the amount, using '.' as the decimal separator
+func (d Decimal) String() string {
+	s := d.integer
+	if s == "" {
+		s = "0"
+	}
+	if d.fraction != "" {
+		s += "." + d.fraction
+	}
+	if d.neg && !d.IsZero() {
+		s = "-" + s
+	}
+	return s
+}
+
+// Sign returns -1, 0 or 1
+func (d Decimal) Sign() int {
+	if d.IsZero() {
+		return 0
+	}
+	if d.neg {
+		return -1
+	}
+	return 1
+}
+
+// IsZero reports whether the amount equals zero
+func (d Decimal) IsZero() bool {
+	return (d.integer == "" || d.integer == "0") && strings.Trim(d.fraction, "0") == ""
+}
+
+// IsInteger reports whether the amount has no non-zero fractional digits
+func (d Decimal) IsInteger() bool {
+	return strings.Trim(d.fraction, "0") == ""
+}
+
+// Abs returns the amount without its sign
+func (d Decimal) Abs() Decimal {
+	d.neg = false
+	return d
+}
+
+// IntegerDigits returns the decimal digits of the integer part, without sign or leading zeros
+func (d Decimal) IntegerDigits() string {
+	if d.integer == "" {
+		return "0"
+	}
+	return d.integer
+}
+
+// Int64 returns the integer part of the amount and whether it fits in an int64
+func (d Decimal) Int64() (int64, bool) {
+	var n int64
+	for i := 0; i < len(d.integer); i++ {
+		digit := int64(d.integer[i] - '0')
+		if n > (1<<63-1-digit)/10 {
+			return 0, false
+		}
+		n = n*10 + digit
+	}
+	if d.neg {
+		n = -n
+	}
+	return n, true
+}
+
+// Round shortens the fraction to at most places digits using the given rounding mode
+func (d Decimal) Round(places int, mode RoundingMode) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if len(d.fraction) <= places {
+		return d
+	}
+
+	kept, dropped := d.fraction[:places], d.fraction[places:]
+	digits := d.integer + kept
+
+	up := false
+	switch mode {
+	case RoundHalfUp:
+		up = dropped[0] >= '5'
+	case RoundHalfEven:
+		switch {
+		case dropped[0] > '5':
+			up = true
+		case dropped[0] == '5':
+			if strings.Trim(dropped[1:], "0") != "" {
+				up = true
+			} else {
+				up = (digits[len(digits)-1]-'0')%2 == 1
+			}
+		}
+	}
+	if up {
+		digits = incrementDigits(digits)
+	}
+
+	d.integer = trimLeadingZeros(digits[:len(digits)-places])
+	d.fraction = digits[len(digits)-places:]
+	return d
+}
+
+// incrementDigits adds one to a decimal digit string
+func incrementDigits(digits string) string {
+	b := []byte(digits)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < '9' {
+			b[i]++
+			return string(b)
+		}
+		b[i] = '0'
+	}
+	return "1" + string(b)
+}
+
+func trimLeadingZeros(digits string) string {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return "0"
+	}
+	return digits
+}
+
+// convertDecimal implements ConvertDecimal on top of a converter's integer reading
+func convertDecimal(nc NumberConverter, amount Decimal, currency string, opts DecimalOptions) (string, error) {
+	if amount.Sign() < 0 {
+		if opts.Sign.Style == SignReject {
+			return "", ErrNegative
+		}
+		text, err := convertDecimal(nc, amount.Abs(), currency, opts)
+		if err != nil {
+			return "", err
+		}
+		// An amount that rounds to zero is read without a sign
+		if opts.Round(amount).IsZero() {
+			return text, nil
+		}
+		return applySign(text, opts.Sign), nil
+	}
+
+	amount = opts.Round(amount)
+	if opts.Fraction == FractionDigits {
+		fraction := strings.TrimRight(amount.fraction, "0")
+		if fraction == "" {
+			return readInteger(nc, amount.integer, currency)
+		}
+
+		result, err := readInteger(nc, amount.integer, "")
+		if err != nil {
+			return "", err
+		}
+		fractionText, err := readFractionDigits(nc, fraction)
+		if err != nil {
+			return "", err
+		}
+		result += " phẩy " + fractionText
+		if currency != "" {
+			result += " " + currency
+		}
+		return result, nil
+	}
+
+	minorDigits := amount.fraction + strings.Repeat("0", opts.MinorDigits-len(amount.fraction))
+	minor, _ := Decimal{integer: trimLeadingZeros(minorDigits)}.Int64()
+	if minor == 0 {
+		return readInteger(nc, amount.integer, currency)
+	}
+
+	minorText, err := nc.ConvertWithCurrency(minor, opts.MinorUnit)
+	if err != nil {
+		return "", err
+	}
+	if amount.integer == "0" {
+		return minorText, nil
+	}
+	majorText, err := readInteger(nc, amount.integer, currency)
+	if err != nil {
+		return "", err
+	}
+	return majorText + " " + minorText, nil
+}
+
+// isThousandsGroup reports whether the single sep in s can group thousands:
+// "500.000" can, "12.5", "0.125" and "1234.567" cannot
+func isThousandsGroup(s string, sep byte) bool {
+	i := strings.IndexByte(s, sep)
+	return i >= 1 && i <= 3 && s[0] != '0' && len(s)-i-1 == 3
+}
+
+// readInteger reads a digit string, switching to the big-number path when it exceeds int64
+func readInteger(nc NumberConverter, digits string, currency string) (string, error) {
+	if n, ok := (Decimal{integer: digits}).Int64(); ok {
+		return nc.ConvertWithCurrency(n, currency)
+	}
+	if bc, ok := nc.(BigConverter); ok {
+		return bc.ConvertDigits(digits, currency)
+	}
+	return "", errTooLarge()
+}
+
+// Round drops the fractional digits that ConvertDecimal will not read with these options
+func (opts DecimalOptions) Round(amount Decimal) Decimal {
+	if opts.Fraction == FractionDigits {
+		if opts.Scale > 0 {
+			return amount.Round(opts.Scale, opts.Rounding)
+		}
+		return amount
+	}
+	return amount.Round(opts.MinorDigits, opts.Rounding)
+}
+
+// readFractionDigits reads the digits after "phẩy": each leading zero is read
+// as "không" and the rest as a whole number, so 0,05 reads "không phẩy không năm"
+func readFractionDigits(nc NumberConverter, fraction string) (string, error) {
+	var parts []string
+	for len(fraction) > 1 && fraction[0] == '0' {
+		parts = append(parts, "không")
+		fraction = fraction[1:]
+	}
+	text, err := readInteger(nc, fraction, "")
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(append(parts, text), " "), nil
+}
@@ -0,0 +1,127 @@
+package converter_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"0", "0"},
+		{"1234567", "1234567"},
+		{"1.234.567,50", "1234567.50"},
+		{"1,234,567.50", "1234567.50"},
+		{"12.5", "12.5"},
+		{"12,5", "12.5"},
+		{"1.234.567", "1234567"},
+		{"007,05", "7.05"},
+		{"-3,5", "-3.5"},
+		// A single separator before three digits groups thousands
+		{"500.000", "500000"},
+		{"1.000", "1000"},
+		{"1,000", "1000"},
+		{"1.234.567,5", "1234567.5"},
+		{"0.125", "0.125"},
+		{"1234,567", "1234.567"},
+		{"1.0005", "1.0005"},
+	}
+
+	for _, tt := range tests {
+		d, err := converter.ParseDecimal(tt.input)
+		if err != nil {
+			t.Errorf("ParseDecimal(%q) returned error: %v", tt.input, err)
+			continue
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "1.2.3,4,5", "12,", ",5", "1.23.456", "1e5", "1,234.5.6"} {
+		if _, err := converter.ParseDecimal(input); err == nil {
+			t.Errorf("ParseDecimal(%q) expected error", input)
+		}
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		input  string
+		places int
+		mode   converter.RoundingMode
+		want   string
+	}{
+		{"0.125", 2, converter.RoundHalfUp, "0.13"},
+		{"0.125", 2, converter.RoundHalfEven, "0.12"},
+		{"0.135", 2, converter.RoundHalfEven, "0.14"},
+		{"0.1251", 2, converter.RoundHalfEven, "0.13"},
+		{"0.129", 2, converter.RoundDown, "0.12"},
+		{"9.9950", 2, converter.RoundHalfUp, "10.00"},
+		{"2.5", 0, converter.RoundHalfEven, "2"},
+		{"1.5", 2, converter.RoundHalfUp, "1.5"},
+	}
+
+	for _, tt := range tests {
+		d, err := converter.ParseDecimal(tt.input)
+		if err != nil {
+			t.Fatalf("ParseDecimal(%q): %v", tt.input, err)
+		}
+		if got := d.Round(tt.places, tt.mode).String(); got != tt.want {
+			t.Errorf("Round(%s, %d, %d) = %s, want %s", tt.input, tt.places, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestConvertDecimal(t *testing.T) {
+	digits := converter.DefaultDecimalOptions()
+	digits.Fraction = converter.FractionDigits
+
+	tests := []struct {
+		input    string
+		currency string
+		opts     converter.DecimalOptions
+		want     string
+	}{
+		{"1.234.567,50", "đồng", converter.DefaultDecimalOptions(),
+			"một triệu hai trăm ba mươi tư nghìn năm trăm sáu mươi bảy đồng năm mươi xu"},
+		{"12.5", "đồng", converter.DefaultDecimalOptions(), "mười hai đồng năm mươi xu"},
+		{"0,05", "đồng", converter.DefaultDecimalOptions(), "năm xu"},
+		{"100,00", "đồng", converter.DefaultDecimalOptions(), "một trăm đồng"},
+		{"9,9990", "đồng", converter.DefaultDecimalOptions(), "mười đồng"},
+		{"500.000", "đồng", converter.DefaultDecimalOptions(), "năm trăm nghìn đồng"},
+		{"12,5", "", digits, "mười hai phẩy năm"},
+		{"3,14", "mét", digits, "ba phẩy mười bốn mét"},
+		{"0,05", "", digits, "không phẩy không năm"},
+		{"7,50", "", digits, "bảy phẩy năm"},
+	}
+
+	engines := map[string]converter.NumberConverter{
+		"original": converter.NewVietnameseConverter(),
+		"turbo":    converter.NewTurboConverter(),
+	}
+
+	for name, engine := range engines {
+		dc, ok := engine.(converter.DecimalConverter)
+		if !ok {
+			t.Fatalf("%s converter does not implement DecimalConverter", name)
+		}
+		for _, tt := range tests {
+			d, err := converter.ParseDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDecimal(%q): %v", tt.input, err)
+			}
+			got, err := dc.ConvertDecimal(d, tt.currency, tt.opts)
+			if err != nil {
+				t.Errorf("%s: ConvertDecimal(%s) returned error: %v", name, tt.input, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%s: ConvertDecimal(%s) = %q, want %q", name, tt.input, got, tt.want)
+			}
+		}
+	}
+}
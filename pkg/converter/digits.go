@@ -0,0 +1,106 @@
+package converter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DigitOptions configures ConvertDigitSequence
+type DigitOptions struct {
+	// Groups are the group sizes read with a pause between them, e.g. {4, 3, 3}
+	// for mobile numbers. The last size repeats for any remaining digits; no
+	// groups read the whole sequence without pauses.
+	Groups []int
+	// Separator marks the pause between groups, ", " when empty
+	Separator string
+}
+
+// Digit grouping patterns for common identifiers
+var (
+	DigitsMobile  = []int{4, 3, 3} // 0912 345 678
+	DigitsTaxCode = []int{10, 3}   // 0101234567-001
+	DigitsAccount = []int{4}       // 1234 5678 9012
+)
+
+// DigitSequenceConverter is implemented by converters that read phone, account
+// and ID numbers digit by digit, keeping leading zeros: "không chín một hai"
+type DigitSequenceConverter interface {
+	ConvertDigitSequence(digits string, opts DigitOptions) (string, error)
+}
+
+// ParseDigitGroups parses a grouping pattern written as "4-3-3"
+func ParseDigitGroups(pattern string) ([]int, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	var groups []int
+	for _, part := range strings.Split(pattern, "-") {
+		size, err := strconv.Atoi(part)
+		if err != nil || size < 1 {
+			return nil, invalidInput(pattern, "invalid digit grouping %q: expected sizes such as 4-3-3", pattern)
+		}
+		groups = append(groups, size)
+	}
+	return groups, nil
+}
+
+// convertDigitSequence implements ConvertDigitSequence with the lexicon's digit words.
+// Spaces, dots, dashes and parentheses in the input are ignored and a leading
+// "+" of an international prefix reads "cộng".
+func convertDigitSequence(lex Lexicon, digits string, opts DigitOptions) (string, error) {
+	var words []string
+	var count int
+	for i, r := range digits {
+		switch {
+		case r >= '0' && r <= '9':
+			words = append(words, lex.Digits[r-'0'])
+			count++
+		case r == '+' && count == 0 && len(words) == 0:
+			words = append(words, "cộng")
+		case r == ' ' || r == '.' || r == '-' || r == '(' || r == ')':
+		default:
+			return "", invalidInput(digits, "invalid digit sequence %q: unexpected character at offset %d", digits, i)
+		}
+	}
+	if count == 0 {
+		return "", invalidInput(digits, "invalid digit sequence %q: no digits", digits)
+	}
+
+	for _, size := range opts.Groups {
+		if size < 1 {
+			return "", invalidInput(strconv.Itoa(size), "invalid digit grouping: group size %d", size)
+		}
+	}
+	if len(opts.Groups) == 0 {
+		return strings.Join(words, " "), nil
+	}
+
+	separator := opts.Separator
+	if separator == "" {
+		separator = ", "
+	}
+
+	var sb strings.Builder
+	if words[0] == "cộng" {
+		sb.WriteString("cộng ")
+		words = words[1:]
+	}
+
+	for g := 0; len(words) > 0; g++ {
+		size := opts.Groups[len(opts.Groups)-1]
+		if g < len(opts.Groups) {
+			size = opts.Groups[g]
+		}
+		if size > len(words) {
+			size = len(words)
+		}
+
+		if g > 0 {
+			sb.WriteString(separator)
+		}
+		sb.WriteString(strings.Join(words[:size], " "))
+		words = words[size:]
+	}
+	return sb.String(), nil
+}
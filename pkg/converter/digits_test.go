@@ -0,0 +1,59 @@
+package converter_test
+
+import (
+	"errors"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestConvertDigitSequence(t *testing.T) {
+	tests := []struct {
+		digits string
+		opts   converter.DigitOptions
+		want   string
+	}{
+		{"0912345678", converter.DigitOptions{}, "không chín một hai ba bốn năm sáu bảy tám"},
+		{"0912345678", converter.DigitOptions{Groups: converter.DigitsMobile}, "không chín một hai, ba bốn năm, sáu bảy tám"},
+		{"0912 345.678", converter.DigitOptions{Groups: converter.DigitsMobile}, "không chín một hai, ba bốn năm, sáu bảy tám"},
+		{"+84 912 345 678", converter.DigitOptions{Groups: []int{2, 3}}, "cộng tám bốn, chín một hai, ba bốn năm, sáu bảy tám"},
+		{"0101234567-001", converter.DigitOptions{Groups: converter.DigitsTaxCode, Separator: " - "},
+			"không một không một hai ba bốn năm sáu bảy - không không một"},
+		{"12345", converter.DigitOptions{Groups: []int{2}}, "một hai, ba bốn, năm"},
+		{"007", converter.DigitOptions{Groups: []int{4, 3, 3}}, "không không bảy"},
+	}
+
+	for _, conv := range []converter.NumberConverter{converter.NewVietnameseConverter(), converter.NewTurboConverter()} {
+		dc := conv.(converter.DigitSequenceConverter)
+		for _, tt := range tests {
+			got, err := dc.ConvertDigitSequence(tt.digits, tt.opts)
+			if err != nil {
+				t.Errorf("ConvertDigitSequence(%q) returned error: %v", tt.digits, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("ConvertDigitSequence(%q) = %q, want %q", tt.digits, got, tt.want)
+			}
+		}
+
+		// Only one leading plus sign is read
+		for _, s := range []string{"", "--", "09a1", "12+3", "8+4", "++84912345678", "+ +84"} {
+			if _, err := dc.ConvertDigitSequence(s, converter.DigitOptions{}); !errors.Is(err, converter.ErrInvalidInput) {
+				t.Errorf("ConvertDigitSequence(%q) error = %v, want ErrInvalidInput", s, err)
+			}
+		}
+	}
+}
+
+func TestParseDigitGroups(t *testing.T) {
+	groups, err := converter.ParseDigitGroups("4-3-3")
+	if err != nil || len(groups) != 3 || groups[0] != 4 || groups[1] != 3 || groups[2] != 3 {
+		t.Errorf("ParseDigitGroups(\"4-3-3\") = %v, %v", groups, err)
+	}
+
+	for _, s := range []string{"4--3", "0", "a-b", "-1"} {
+		if _, err := converter.ParseDigitGroups(s); err == nil {
+			t.Errorf("ParseDigitGroups(%q) should fail", s)
+		}
+	}
+}
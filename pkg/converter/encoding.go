@@ -0,0 +1,230 @@
+package converter
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Encoding selects the character form of a converter's output
+type Encoding int
+
+const (
+	// EncodingNFC writes precomposed letters, one code point per letter: "đồng" (default)
+	EncodingNFC Encoding = iota
+	// EncodingNFD writes each letter as its base followed by combining marks,
+	// for downstream systems that compare decomposed bytes
+	EncodingNFD
+	// EncodingASCII drops all diacritics for SMS gateways and legacy fields: "dong"
+	EncodingASCII
+)
+
+// WithEncoding selects the character form of everything the converter returns
+func WithEncoding(enc Encoding) Option {
+	return func(o *options) {
+		o.encoding = enc
+	}
+}
+
+// vietnameseLetters lists each vowel with its tones in the order
+// none, huyền, sắc, hỏi, ngã, nặng
+var vietnameseLetters = []struct {
+	row      string
+	modifier rune // breve, circumflex or horn; 0 for a plain vowel
+}{
+	{"aàáảãạ", 0}, {"ăằắẳẵặ", '\u0306'}, {"âầấẩẫậ", '\u0302'},
+	{"eèéẻẽẹ", 0}, {"êềếểễệ", '\u0302'},
+	{"iìíỉĩị", 0},
+	{"oòóỏõọ", 0}, {"ôồốổỗộ", '\u0302'}, {"ơờớởỡợ", '\u031b'},
+	{"uùúủũụ", 0}, {"ưừứửữự", '\u031b'},
+	{"yỳýỷỹỵ", 0},
+	{"AÀÁẢÃẠ", 0}, {"ĂẰẮẲẴẶ", '\u0306'}, {"ÂẦẤẨẪẬ", '\u0302'},
+	{"EÈÉẺẼẸ", 0}, {"ÊỀẾỂỄỆ", '\u0302'},
+	{"IÌÍỈĨỊ", 0},
+	{"OÒÓỎÕỌ", 0}, {"ÔỒỐỔỖỘ", '\u0302'}, {"ƠỜỚỞỠỢ", '\u031b'},
+	{"UÙÚỦŨỤ", 0}, {"ƯỪỨỬỮỰ", '\u031b'},
+	{"YỲÝỶỸỴ", 0},
+}
+
+// toneMarks are the combining tone marks in vietnameseLetters order
+var toneMarks = [6]rune{0, '\u0300', '\u0301', '\u0309', '\u0303', '\u0323'}
+
+var (
+	// decomposed maps a precomposed letter to its base and canonically ordered marks
+	decomposed = map[rune]string{}
+	// composed maps a base letter and canonically ordered marks back to one letter
+	composed = map[string]rune{}
+)
+
+func init() {
+	for _, letters := range vietnameseLetters {
+		runes := []rune(letters.row)
+		// The base of "ă", "â", "ơ", ... is the plain vowel
+		base := plainVowel(runes[0])
+		for tone, letter := range runes {
+			var marks []rune
+			if letters.modifier != 0 {
+				marks = append(marks, letters.modifier)
+			}
+			if toneMarks[tone] != 0 {
+				marks = append(marks, toneMarks[tone])
+			}
+			if len(marks) == 0 {
+				continue
+			}
+			sortMarks(marks)
+			nfd := string(base) + string(marks)
+			decomposed[letter] = nfd
+			composed[nfd] = letter
+		}
+	}
+}
+
+// plainVowel returns the ASCII vowel under a letter with a breve, circumflex or horn
+func plainVowel(r rune) rune {
+	switch r {
+	case 'ă', 'â':
+		return 'a'
+	case 'Ă', 'Â':
+		return 'A'
+	case 'ê':
+		return 'e'
+	case 'Ê':
+		return 'E'
+	case 'ô', 'ơ':
+		return 'o'
+	case 'Ô', 'Ơ':
+		return 'O'
+	case 'ư':
+		return 'u'
+	case 'Ư':
+		return 'U'
+	}
+	return r
+}
+
+// combiningClass returns the canonical combining class of the marks Vietnamese uses
+func combiningClass(r rune) int {
+	switch r {
+	case '\u031b': // horn
+		return 216
+	case '\u0323': // dot below
+		return 220
+	case '\u0300', '\u0301', '\u0302', '\u0303', '\u0306', '\u0309':
+		return 230
+	}
+	return 0
+}
+
+// sortMarks puts combining marks in canonical order, keeping equal classes in place
+func sortMarks(marks []rune) {
+	sort.SliceStable(marks, func(i, j int) bool {
+		return combiningClass(marks[i]) < combiningClass(marks[j])
+	})
+}
+
+// Encode converts text to enc. Input may mix precomposed and decomposed letters.
+// In ASCII, characters with no ASCII base letter become '?'.
+func Encode(text string, enc Encoding) string {
+	if !needsEncoding(text, enc) {
+		return text
+	}
+	return string(AppendEncoded(make([]byte, 0, len(text)+len(text)/2), text, enc))
+}
+
+// needsEncoding reports whether Encode would change text: plain ASCII never
+// changes, and precomposed text is already NFC
+func needsEncoding(text string, enc Encoding) bool {
+	for _, r := range text {
+		if r >= utf8.RuneSelf && (enc != EncodingNFC || combiningClass(r) != 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// AppendEncoded appends text converted to enc to dst
+func AppendEncoded(dst []byte, text string, enc Encoding) []byte {
+	var marks []rune
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+
+		// Split the letter into its base and marks, and pick up any marks that follow
+		base := r
+		marks = marks[:0]
+		if nfd, ok := decomposed[r]; ok {
+			for j, m := range nfd {
+				if j == 0 {
+					base = m
+				} else {
+					marks = append(marks, m)
+				}
+			}
+		}
+		for i < len(text) {
+			m, size := utf8.DecodeRuneInString(text[i:])
+			if combiningClass(m) == 0 {
+				break
+			}
+			marks = append(marks, m)
+			i += size
+		}
+
+		switch enc {
+		case EncodingASCII:
+			switch {
+			case base == 'đ':
+				dst = append(dst, 'd')
+			case base == 'Đ':
+				dst = append(dst, 'D')
+			case base < utf8.RuneSelf:
+				dst = append(dst, byte(base))
+			case combiningClass(base) != 0:
+				// a stray mark with nothing to sit on
+			default:
+				dst = append(dst, '?')
+			}
+			continue
+		case EncodingNFC:
+			if len(marks) > 0 {
+				sortMarks(marks)
+				if letter, ok := composed[string(base)+string(marks)]; ok {
+					dst = utf8.AppendRune(dst, letter)
+					continue
+				}
+			}
+		case EncodingNFD:
+			sortMarks(marks)
+		}
+
+		dst = utf8.AppendRune(dst, base)
+		for _, m := range marks {
+			dst = utf8.AppendRune(dst, m)
+		}
+	}
+	return dst
+}
+
+// encodeResult applies the converter's encoding to a finished reading
+func (o options) encodeResult(text string, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	return Encode(text, o.encoding), nil
+}
+
+// encodeTail applies the converter's encoding to dst[start:], the part just
+// appended. It does not allocate when the tail is already in the right form.
+func (o options) encodeTail(dst []byte, start int) []byte {
+	tail := dst[start:]
+	changes := false
+	for i := 0; i < len(tail) && !changes; {
+		r, size := utf8.DecodeRune(tail[i:])
+		changes = r >= utf8.RuneSelf && (o.encoding != EncodingNFC || combiningClass(r) != 0)
+		i += size
+	}
+	if !changes {
+		return dst
+	}
+	return AppendEncoded(dst[:start], string(tail), o.encoding)
+}
@@ -0,0 +1,69 @@
+package converter_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		text string
+		enc  converter.Encoding
+		want string
+	}{
+		{"một triệu đồng", converter.EncodingASCII, "mot trieu dong"},
+		{"Đúng hai mươi lăm nghìn", converter.EncodingASCII, "Dung hai muoi lam nghin"},
+		{"đồng", converter.EncodingASCII, "dong"},
+		{"°C", converter.EncodingASCII, "?C"},
+		{"đồng", converter.EncodingNFD, "đo\u0302\u0300ng"},
+		// Dot below (class 220) sorts before the circumflex (230), the horn (216) before both
+		{"một", converter.EncodingNFD, "mo\u0323\u0302t"},
+		{"ợ", converter.EncodingNFD, "o\u031b\u0323"},
+		{"o\u0302\u0323", converter.EncodingNFD, "o\u0323\u0302"},
+		{"mo\u0323\u0302t", converter.EncodingNFC, "một"},
+		{"đo\u0302\u0300ng", converter.EncodingNFC, "đồng"},
+		{"o\u0302\u0323", converter.EncodingNFC, "ộ"},
+		{"plain text", converter.EncodingNFD, "plain text"},
+	}
+
+	for _, tt := range tests {
+		if got := converter.Encode(tt.text, tt.enc); got != tt.want {
+			t.Errorf("Encode(%q, %d) = %+q, want %+q", tt.text, tt.enc, got, tt.want)
+		}
+	}
+}
+
+func TestWithEncoding(t *testing.T) {
+	tests := []struct {
+		enc  converter.Encoding
+		want string
+	}{
+		{converter.EncodingNFC, "một nghìn không trăm hai mươi mốt đồng"},
+		{converter.EncodingASCII, "mot nghin khong tram hai muoi mot dong"},
+		{converter.EncodingNFD, converter.Encode("một nghìn không trăm hai mươi mốt đồng", converter.EncodingNFD)},
+	}
+
+	for _, newConv := range []func(...converter.Option) converter.NumberConverter{converter.NewVietnameseConverter, converter.NewTurboConverter} {
+		for _, tt := range tests {
+			conv := newConv(converter.WithEncoding(tt.enc))
+			if got, err := conv.Convert(1021); err != nil || got != tt.want {
+				t.Errorf("encoding %d: Convert(1021) = %q, %v, want %q", tt.enc, got, err, tt.want)
+			}
+
+			buf, err := conv.(converter.AppendConverter).AppendConvert([]byte("= "), 1021, "đồng")
+			if err != nil || string(buf) != "= "+tt.want {
+				t.Errorf("encoding %d: AppendConvert = %q, %v, want %q", tt.enc, buf, err, "= "+tt.want)
+			}
+		}
+
+		// Every reading goes through the encoding, including words added around the number
+		conv := newConv(converter.WithEncoding(converter.EncodingASCII))
+		if got, _ := conv.(converter.OrdinalConverter).ConvertOrdinal(4); got != "thu tu" {
+			t.Errorf("ASCII ConvertOrdinal(4) = %q, want %q", got, "thu tu")
+		}
+		if got, _ := conv.(converter.QuantityConverter).ConvertFraction(3, 4); got != "ba phan tu" {
+			t.Errorf("ASCII ConvertFraction(3, 4) = %q, want %q", got, "ba phan tu")
+		}
+	}
+}
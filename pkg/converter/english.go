@@ -0,0 +1,196 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AndStyle selects where English puts "and" inside a number
+type AndStyle int
+
+const (
+	// AndNone never uses "and" in the number: "one hundred five" (US)
+	AndNone AndStyle = iota
+	// AndBritish puts "and" before the tens and units: "one hundred and five",
+	// "one thousand and five" (UK)
+	AndBritish
+)
+
+// EnglishOptions configures English output
+type EnglishOptions struct {
+	And AndStyle
+}
+
+// English reads amounts in English with the short scale (million, billion, trillion, ...)
+type English struct {
+	opts EnglishOptions
+}
+
+// NewEnglish creates the English language
+func NewEnglish(opts EnglishOptions) *English {
+	return &English{opts: opts}
+}
+
+var (
+	englishOnes = [20]string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen",
+		"sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	englishTens = [10]string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+	englishScales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+		"sextillion", "septillion", "octillion", "nonillion", "decillion",
+	}
+)
+
+// englishLimit is the first number the short-scale names cannot read
+var englishLimit = "1" + strings.Repeat("0", 3*len(englishScales))
+
+func (e *English) Code() string {
+	return "en"
+}
+
+// ConvertAmount reads amount in English: "twelve US dollars and fifty cents".
+// Registered currencies take their English names; opts.MinorUnit is used only
+// for currencies outside the registry. Negative amounts read "minus".
+func (e *English) ConvertAmount(amount Decimal, currency string, opts DecimalOptions) (string, error) {
+	if amount.Sign() < 0 {
+		if opts.Sign.Style == SignReject {
+			return "", ErrNegative
+		}
+		text, err := e.ConvertAmount(amount.Abs(), currency, opts)
+		if err != nil {
+			return "", err
+		}
+		// An amount that rounds to zero is read without a sign
+		if opts.Round(amount).IsZero() {
+			return text, nil
+		}
+		return applySign(text, SignOptions{Style: opts.Sign.Style, Prefix: "minus"}), nil
+	}
+
+	names := CurrencyNames{Major: currency, MajorPlural: currency, Minor: opts.MinorUnit, MinorPlural: opts.MinorUnit}
+	if c, ok := LookupCurrency(currency); ok {
+		names = c.English
+		if names.Major == "" {
+			names.Major, names.MajorPlural = c.Code, c.Code
+		}
+	}
+
+	amount = opts.Round(amount)
+	integer, err := e.readDigits(amount.integer)
+	if err != nil {
+		return "", err
+	}
+
+	if opts.Fraction == FractionDigits {
+		fraction := strings.TrimRight(amount.fraction, "0")
+		if fraction == "" {
+			return withUnit(integer, amount.integer == "1", names.Major, names.MajorPlural), nil
+		}
+		// Digits after "point" are read one by one: 0.05 is "zero point zero five"
+		words := make([]string, len(fraction))
+		for i := range fraction {
+			words[i] = englishOnes[fraction[i]-'0']
+		}
+		return withUnit(integer+" point "+strings.Join(words, " "), false, names.Major, names.MajorPlural), nil
+	}
+
+	minorDigits := amount.fraction + strings.Repeat("0", opts.MinorDigits-len(amount.fraction))
+	minor := trimLeadingZeros(minorDigits)
+	if minor == "0" {
+		return withUnit(integer, amount.integer == "1", names.Major, names.MajorPlural), nil
+	}
+
+	minorText, err := e.readDigits(minor)
+	if err != nil {
+		return "", err
+	}
+	minorText = withUnit(minorText, minor == "1", names.Minor, names.MinorPlural)
+	if amount.integer == "0" {
+		return minorText, nil
+	}
+	return withUnit(integer, amount.integer == "1", names.Major, names.MajorPlural) + " and " + minorText, nil
+}
+
+// withUnit appends the singular or plural unit word, if any
+func withUnit(text string, one bool, singular, plural string) string {
+	unit := plural
+	if one {
+		unit = singular
+	}
+	if unit == "" {
+		return text
+	}
+	return text + " " + unit
+}
+
+// readDigits reads a non-negative integer given as decimal digits
+func (e *English) readDigits(digits string) (string, error) {
+	groups, err := splitDigitGroups(digits)
+	if err != nil {
+		return "", err
+	}
+	if len(groups) == 0 {
+		return englishOnes[0], nil
+	}
+	if len(groups) > len(englishScales) {
+		return "", &Error{
+			Kind:  ErrOutOfRange,
+			Input: digits,
+			Limit: englishLimit,
+			Msg:   fmt.Sprintf("number too large for English (max: %d digits)", 3*len(englishScales)),
+		}
+	}
+
+	var sb strings.Builder
+	for i, group := range groups {
+		if group == 0 {
+			continue
+		}
+		scale := len(groups) - 1 - i
+		if sb.Len() > 0 {
+			// British style joins a last group below one hundred with "and": "one thousand and five"
+			if e.opts.And == AndBritish && scale == 0 && group < 100 {
+				sb.WriteString(" and")
+			}
+			sb.WriteByte(' ')
+		}
+		e.appendGroup(&sb, group)
+		if scale > 0 {
+			sb.WriteByte(' ')
+			sb.WriteString(englishScales[scale])
+		}
+	}
+	return sb.String(), nil
+}
+
+// appendGroup reads 1-999: "one hundred twenty-one"
+func (e *English) appendGroup(sb *strings.Builder, group int) {
+	hundreds, rest := group/100, group%100
+	if hundreds > 0 {
+		sb.WriteString(englishOnes[hundreds])
+		sb.WriteString(" hundred")
+		if rest == 0 {
+			return
+		}
+		sb.WriteByte(' ')
+		if e.opts.And == AndBritish {
+			sb.WriteString("and ")
+		}
+	}
+
+	switch {
+	case rest < 20:
+		sb.WriteString(englishOnes[rest])
+	case rest%10 == 0:
+		sb.WriteString(englishTens[rest/10])
+	default:
+		sb.WriteString(englishTens[rest/10])
+		sb.WriteByte('-')
+		sb.WriteString(englishOnes[rest%10])
+	}
+}
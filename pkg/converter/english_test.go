@@ -0,0 +1,114 @@
+package converter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestEnglishConvertAmount(t *testing.T) {
+	us := converter.NewEnglish(converter.EnglishOptions{})
+	uk := converter.NewEnglish(converter.EnglishOptions{And: converter.AndBritish})
+
+	tests := []struct {
+		lang     *converter.English
+		amount   string
+		currency string
+		want     string
+	}{
+		{us, "0", "", "zero"},
+		{us, "21", "", "twenty-one"},
+		{us, "105", "", "one hundred five"},
+		{uk, "105", "", "one hundred and five"},
+		{uk, "1005", "", "one thousand and five"},
+		{uk, "1200", "", "one thousand two hundred"},
+		{us, "1200000", "VND", "one million two hundred thousand dong"},
+		{us, "1.234.567,6", "VND", "one million two hundred thirty-four thousand five hundred sixty-seven dong and sixty xu"},
+		{us, "1000000000000", "", "one trillion"},
+		{us, "9223372036854775807", "",
+			"nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion " +
+				"eight hundred fifty-four million seven hundred seventy-five thousand eight hundred seven"},
+		{us, "12.5", "USD", "twelve US dollars and fifty cents"},
+		{us, "1.01", "usd", "one US dollar and one cent"},
+		{us, "0,99", "EUR", "ninety-nine cents"},
+		{uk, "3.05", "GBP", "three pounds sterling and five pence"},
+		{us, "1", "JPY", "one yen"},
+		{us, "7", "chiếc", "seven chiếc"},
+	}
+
+	for _, tt := range tests {
+		amount, err := converter.ParseDecimal(tt.amount)
+		if err != nil {
+			t.Fatalf("ParseDecimal(%q): %v", tt.amount, err)
+		}
+		opts := converter.DefaultDecimalOptions()
+		if c, ok := converter.LookupCurrency(tt.currency); ok {
+			opts = c.DecimalOptions(opts)
+		}
+		got, err := tt.lang.ConvertAmount(amount, tt.currency, opts)
+		if err != nil {
+			t.Errorf("ConvertAmount(%s %s) returned error: %v", tt.amount, tt.currency, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ConvertAmount(%s %s) = %q, want %q", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestEnglishOptions(t *testing.T) {
+	en := converter.NewEnglish(converter.EnglishOptions{})
+	tests := []struct {
+		amount string
+		opts   func(*converter.DecimalOptions)
+		want   string
+	}{
+		{"1.05", func(o *converter.DecimalOptions) { o.Fraction = converter.FractionDigits }, "one point zero five"},
+		{"-5", func(o *converter.DecimalOptions) { o.Sign.Style = converter.SignWord; o.Sign.Prefix = "trừ" }, "minus five"},
+		{"-5", func(o *converter.DecimalOptions) { o.Sign.Style = converter.SignAccounting }, "(five)"},
+	}
+	for _, tt := range tests {
+		amount, _ := converter.ParseDecimal(tt.amount)
+		opts := converter.DefaultDecimalOptions()
+		tt.opts(&opts)
+		if got, err := en.ConvertAmount(amount, "", opts); err != nil || got != tt.want {
+			t.Errorf("ConvertAmount(%s) = %q, %v, want %q", tt.amount, got, err, tt.want)
+		}
+	}
+
+	negative, _ := converter.ParseDecimal("-5")
+	if _, err := en.ConvertAmount(negative, "", converter.DefaultDecimalOptions()); !errors.Is(err, converter.ErrNegative) {
+		t.Errorf("negative amount error = %v, want ErrNegative", err)
+	}
+	huge, _ := converter.ParseDecimal("1" + strings.Repeat("0", 36))
+	if _, err := en.ConvertAmount(huge, "", converter.DefaultDecimalOptions()); !errors.Is(err, converter.ErrOutOfRange) {
+		t.Errorf("1e36 error = %v, want ErrOutOfRange", err)
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	amount, _ := converter.ParseDecimal("1200000")
+	want := map[string]string{
+		"vi": "một triệu hai trăm nghìn đồng",
+		"en": "one million two hundred thousand dong",
+	}
+	for code, text := range want {
+		lang, ok := converter.LookupLanguage(strings.ToUpper(code))
+		if !ok {
+			t.Fatalf("LookupLanguage(%q) not found", code)
+		}
+		got, err := lang.ConvertAmount(amount, "VND", converter.DefaultDecimalOptions())
+		if err != nil || got != text {
+			t.Errorf("%s: ConvertAmount = %q, %v, want %q", code, got, err, text)
+		}
+	}
+
+	if err := converter.RegisterLanguage(nil); err == nil {
+		t.Error("RegisterLanguage(nil) should fail")
+	}
+	if got := converter.Languages(); len(got) < 2 || got[0] != "en" || got[1] != "vi" {
+		t.Errorf("Languages() = %v, want [en vi]", got)
+	}
+}
@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Sentinel errors, matched with errors.Is. Errors carrying details are *Error
+// values wrapping one of them.
+var (
+	// ErrNegative reports a negative number where only non-negative ones are read
+	ErrNegative = errors.New("negative numbers not supported")
+	// ErrOutOfRange reports a number beyond what the operation accepts; see Error.Limit
+	ErrOutOfRange = errors.New("number out of range")
+	// ErrInvalidInput reports input that cannot be read at all, such as "12a"
+	ErrInvalidInput = errors.New("invalid input")
+)
+
+// Error is a converter error with details for callers that report them,
+// such as an HTTP API. It matches its Kind with errors.Is.
+type Error struct {
+	Kind  error  // ErrNegative, ErrOutOfRange or ErrInvalidInput
+	Input string // the offending input, when known
+	Limit string // for ErrOutOfRange, the bound that was crossed
+	Msg   string
+}
+
+func (e *Error) Error() string {
+	if e.Msg == "" {
+		return e.Kind.Error()
+	}
+	return e.Msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+// maxInt64Limit is the limit reported when a reading needs an int64
+var maxInt64Limit = strconv.FormatInt(math.MaxInt64, 10)
+
+// errTooLarge reports a number that does not fit the converter's integer reading
+func errTooLarge() error {
+	return &Error{
+		Kind:  ErrOutOfRange,
+		Limit: maxInt64Limit,
+		Msg:   fmt.Sprintf("number too large (max: %s)", maxInt64Limit),
+	}
+}
+
+// invalidInput reports unreadable input with a formatted message
+func invalidInput(input string, format string, args ...interface{}) error {
+	return &Error{
+		Kind:  ErrInvalidInput,
+		Input: input,
+		Msg:   fmt.Sprintf(format, args...),
+	}
+}
@@ -0,0 +1,61 @@
+package converter_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestErrorKinds(t *testing.T) {
+	turbo := converter.NewTurboConverter()
+	negative := big.NewInt(-1)
+
+	tests := []struct {
+		name string
+		err  error
+		kind error
+	}{
+		{"negative", errOf(turbo.Convert(-1)), converter.ErrNegative},
+		{"negative big", errOf(turbo.(converter.BigConverter).ConvertBig(negative, "")), converter.ErrNegative},
+		{"negative decimal", errOf(turbo.(converter.DecimalConverter).ConvertDecimal(mustDecimal(t, "-1,5"), "đồng", converter.DefaultDecimalOptions())), converter.ErrNegative},
+		{"ordinal zero", errOf(turbo.(converter.OrdinalConverter).ConvertOrdinal(0)), converter.ErrOutOfRange},
+		{"bad decimal", errOf(converter.ParseDecimal("12a")), converter.ErrInvalidInput},
+		{"bad digits", errOf(turbo.(converter.BigConverter).ConvertDigits("12a", "")), converter.ErrInvalidInput},
+		{"bad text", errOf(converter.ParseAmount("một trăm lăm")), converter.ErrInvalidInput},
+		{"unknown currency", errOf(converter.ConvertCurrency(turbo.(converter.DecimalConverter), mustDecimal(t, "1"), "XXX", converter.DefaultDecimalOptions())), converter.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		if !errors.Is(tt.err, tt.kind) {
+			t.Errorf("%s: error %v does not match %v", tt.name, tt.err, tt.kind)
+		}
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	_, err := converter.ParseDecimal("12a")
+	var ce *converter.Error
+	if !errors.As(err, &ce) || ce.Input != "12a" {
+		t.Errorf("ParseDecimal(\"12a\") error = %#v, want *Error with Input \"12a\"", err)
+	}
+
+	_, err = converter.NewTurboConverter().(converter.OrdinalConverter).ConvertOrdinal(-2)
+	if !errors.As(err, &ce) || ce.Limit != "1" || ce.Input != "-2" {
+		t.Errorf("ConvertOrdinal(-2) error = %#v, want *Error with Limit \"1\"", err)
+	}
+}
+
+func errOf(_ interface{}, err error) error {
+	return err
+}
+
+func mustDecimal(t *testing.T, s string) converter.Decimal {
+	t.Helper()
+	d, err := converter.ParseDecimal(s)
+	if err != nil {
+		t.Fatalf("ParseDecimal(%q): %v", s, err)
+	}
+	return d
+}
@@ -0,0 +1,46 @@
+package converter
+
+import "strings"
+
+// TokenKind classifies the words of an explained reading
+type TokenKind string
+
+const (
+	TokenUnit      TokenKind = "unit"      // a units digit: "năm", "mốt", "tư", "lăm"
+	TokenTen       TokenKind = "ten"       // a tens digit: "mười", "hai mươi"
+	TokenHundred   TokenKind = "hundred"   // a hundreds digit: "một trăm"
+	TokenScale     TokenKind = "scale"     // "nghìn", "triệu", "tỷ" after a group
+	TokenConnector TokenKind = "connector" // a zero digit that is spoken: "không trăm", "lẻ"
+	TokenCurrency  TokenKind = "currency"
+)
+
+// Token is one word or phrase of a reading with the digits it came from.
+// Start and End index Explanation.Digits; scale words cover their whole
+// group and the currency covers every digit.
+type Token struct {
+	Word  string    `json:"word"`
+	Kind  TokenKind `json:"kind"`
+	Start int       `json:"start"`
+	End   int       `json:"end"` // exclusive
+}
+
+// Explanation is a reading split into tokens
+type Explanation struct {
+	Digits string  `json:"digits"` // the number without leading zeros
+	Tokens []Token `json:"tokens"`
+}
+
+// Text joins the tokens into the reading Convert returns
+func (e Explanation) Text() string {
+	words := make([]string, len(e.Tokens))
+	for i, tok := range e.Tokens {
+		words[i] = tok.Word
+	}
+	return strings.Join(words, " ")
+}
+
+// Explainer is implemented by converters that can show which digits
+// produced which words, for highlighting and debugging
+type Explainer interface {
+	Explain(digits string, currency string) (Explanation, error)
+}
@@ -0,0 +1,94 @@
+package converter_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestExplain(t *testing.T) {
+	explainer := converter.NewVietnameseConverter().(converter.Explainer)
+
+	tests := []struct {
+		digits string
+		want   []converter.Token
+	}{
+		{"1005000", []converter.Token{
+			{Word: "một", Kind: converter.TokenUnit, Start: 0, End: 1},
+			{Word: "triệu", Kind: converter.TokenScale, Start: 0, End: 1},
+			{Word: "không trăm", Kind: converter.TokenConnector, Start: 1, End: 2},
+			{Word: "năm", Kind: converter.TokenUnit, Start: 3, End: 4},
+			{Word: "nghìn", Kind: converter.TokenScale, Start: 1, End: 4},
+			{Word: "đồng", Kind: converter.TokenCurrency, Start: 0, End: 7},
+		}},
+		{"0105", []converter.Token{
+			{Word: "một trăm", Kind: converter.TokenHundred, Start: 0, End: 1},
+			{Word: "lẻ", Kind: converter.TokenConnector, Start: 1, End: 2},
+			{Word: "năm", Kind: converter.TokenUnit, Start: 2, End: 3},
+			{Word: "đồng", Kind: converter.TokenCurrency, Start: 0, End: 3},
+		}},
+		{"21", []converter.Token{
+			{Word: "hai mươi", Kind: converter.TokenTen, Start: 0, End: 1},
+			{Word: "mốt", Kind: converter.TokenUnit, Start: 1, End: 2},
+			{Word: "đồng", Kind: converter.TokenCurrency, Start: 0, End: 2},
+		}},
+		{"0", []converter.Token{
+			{Word: "không", Kind: converter.TokenUnit, Start: 0, End: 1},
+			{Word: "đồng", Kind: converter.TokenCurrency, Start: 0, End: 1},
+		}},
+	}
+
+	for _, tt := range tests {
+		got, err := explainer.Explain(tt.digits, "đồng")
+		if err != nil {
+			t.Fatalf("Explain(%q): %v", tt.digits, err)
+		}
+		if !reflect.DeepEqual(got.Tokens, tt.want) {
+			t.Errorf("Explain(%q) tokens = %+v, want %+v", tt.digits, got.Tokens, tt.want)
+		}
+	}
+
+	// Chained "tỷ" words all point at the group they follow
+	got, _ := explainer.Explain("1000000000000000000", "")
+	if got.Text() != "một tỷ tỷ" || got.Tokens[2].Start != 0 || got.Tokens[2].End != 1 {
+		t.Errorf("Explain(10^18) = %+v", got)
+	}
+
+	if _, err := explainer.Explain("12a", ""); err == nil {
+		t.Error("Explain(12a) should fail")
+	}
+}
+
+func TestExplainMatchesConvert(t *testing.T) {
+	convs := map[string]converter.NumberConverter{
+		"northern": converter.NewVietnameseConverter(),
+		"southern": converter.NewVietnameseConverter(converter.WithDialect(converter.DialectSouthern), converter.WithFiveAsNham()),
+		"ascii":    converter.NewVietnameseConverter(converter.WithEncoding(converter.EncodingASCII)),
+		"turbo":    converter.NewTurboConverter(),
+		"turbo_southern": converter.NewTurboConverter(converter.WithDialect(converter.DialectSouthern),
+			converter.WithZeroPolicy(converter.ZeroPolicy{OmitZeroHundreds: true, OddZeroAlways: true})),
+	}
+	numbers := []int64{1_234_567_890_123, 9_223_372_036_854_775_807, 1_000_001, 10_015, 500_000_024}
+	for n := int64(0); n < 20_000; n += 7 {
+		numbers = append(numbers, n)
+	}
+
+	for name, conv := range convs {
+		explainer := conv.(converter.Explainer)
+		for _, n := range numbers {
+			digits := strconv.FormatInt(n, 10)
+			want, _ := conv.Convert(n)
+			got, err := explainer.Explain(digits, "đồng")
+			if err != nil || got.Text() != want {
+				t.Fatalf("%s: Explain(%s).Text() = %q, %v, want %q", name, digits, got.Text(), err, want)
+			}
+			for _, tok := range got.Tokens {
+				if tok.Start < 0 || tok.End > len(got.Digits) || tok.Start >= tok.End {
+					t.Fatalf("%s: Explain(%s) token %+v outside the digits", name, digits, tok)
+				}
+			}
+		}
+	}
+}
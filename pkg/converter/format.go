@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Capitalization controls the letter case of formatted text
+type Capitalization int
+
+const (
+	// CapNone keeps the converter output as is
+	CapNone Capitalization = iota
+	// CapFirst upper-cases the first letter: "Một triệu đồng"
+	CapFirst
+	// CapUpper upper-cases everything: "MỘT TRIỆU ĐỒNG"
+	CapUpper
+)
+
+// Format preset names
+const (
+	PresetInvoice   = "invoice"
+	PresetCheque    = "cheque"
+	PresetPlain     = "plain"
+	PresetUppercase = "uppercase"
+)
+
+// FormatProfile shapes converter output for a document, such as the
+// "số tiền bằng chữ" line of an e-invoice: "Một triệu đồng chẵn./."
+type FormatProfile struct {
+	Capitalization Capitalization
+	EvenSuffix     string   // appended to whole amounts, e.g. "chẵn"; empty to disable
+	Terminator     string   // appended last, e.g. "./."
+	Encoding       Encoding // character form of the final text, so "chẵn" follows the converter's
+}
+
+// Preset returns the named format profile
+func Preset(name string) (FormatProfile, bool) {
+	switch name {
+	case PresetInvoice:
+		return FormatProfile{Capitalization: CapFirst, Terminator: "./."}, true
+	case PresetCheque:
+		return FormatProfile{Capitalization: CapFirst, EvenSuffix: "chẵn", Terminator: "."}, true
+	case PresetPlain:
+		return FormatProfile{}, true
+	case PresetUppercase:
+		return FormatProfile{Capitalization: CapUpper}, true
+	}
+	return FormatProfile{}, false
+}
+
+// Apply formats converted text. whole reports whether the amount has no
+// minor units, which decides if EvenSuffix is added.
+func (p FormatProfile) Apply(text string, whole bool) string {
+	if whole && p.EvenSuffix != "" {
+		text = appendBeforeClosing(text, " "+p.EvenSuffix)
+	}
+
+	switch p.Capitalization {
+	case CapFirst:
+		text = capitalizeFirst(text)
+	case CapUpper:
+		text = strings.ToUpper(text)
+	}
+
+	return Encode(text+p.Terminator, p.Encoding)
+}
+
+// appendBeforeClosing appends s inside accounting parentheses when present
+func appendBeforeClosing(text, s string) string {
+	if strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")") {
+		return text[:len(text)-1] + s + ")"
+	}
+	return text + s
+}
+
+// capitalizeFirst upper-cases the first letter, skipping leading punctuation
+func capitalizeFirst(text string) string {
+	for i, r := range text {
+		if unicode.IsLetter(r) {
+			upper := unicode.ToUpper(r)
+			return text[:i] + string(upper) + text[i+utf8.RuneLen(r):]
+		}
+	}
+	return text
+}
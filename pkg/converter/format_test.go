@@ -0,0 +1,46 @@
+package converter_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestFormatPresets(t *testing.T) {
+	tests := []struct {
+		preset string
+		text   string
+		whole  bool
+		want   string
+	}{
+		{converter.PresetInvoice, "một triệu đồng", true, "Một triệu đồng./."},
+		{converter.PresetInvoice, "đồng năm mươi xu", false, "Đồng năm mươi xu./."},
+		{converter.PresetCheque, "một triệu đồng", true, "Một triệu đồng chẵn."},
+		{converter.PresetCheque, "mười hai đồng năm mươi xu", false, "Mười hai đồng năm mươi xu."},
+		{converter.PresetCheque, "(một triệu đồng)", true, "(Một triệu đồng chẵn)."},
+		{converter.PresetPlain, "một triệu đồng", true, "một triệu đồng"},
+		{converter.PresetUppercase, "một triệu đồng", true, "MỘT TRIỆU ĐỒNG"},
+	}
+
+	for _, tt := range tests {
+		profile, ok := converter.Preset(tt.preset)
+		if !ok {
+			t.Fatalf("Preset(%q) not found", tt.preset)
+		}
+		if got := profile.Apply(tt.text, tt.whole); got != tt.want {
+			t.Errorf("%s.Apply(%q) = %q, want %q", tt.preset, tt.text, got, tt.want)
+		}
+	}
+
+	if _, ok := converter.Preset("fancy"); ok {
+		t.Errorf("Preset(\"fancy\") should not exist")
+	}
+}
+
+func TestFormatEncoding(t *testing.T) {
+	profile, _ := converter.Preset(converter.PresetCheque)
+	profile.Encoding = converter.EncodingASCII
+	if got, want := profile.Apply("một triệu đồng", true), "Mot trieu dong chan."; got != want {
+		t.Errorf("ASCII cheque Apply = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,91 @@
+package converter
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Language reads amounts in one output language, so that bilingual invoices
+// and contracts can print the same amount in each of them
+type Language interface {
+	// Code is the language tag, e.g. "vi" or "en"
+	Code() string
+	// ConvertAmount reads amount followed by its unit. A currency code from the
+	// registry is named in this language; any other currency is used as given,
+	// and an empty one reads the bare number. opts selects fraction reading,
+	// rounding and the sign as for ConvertDecimal.
+	ConvertAmount(amount Decimal, currency string, opts DecimalOptions) (string, error)
+}
+
+// vietnameseLanguage adapts a DecimalConverter to the Language interface
+type vietnameseLanguage struct {
+	dc DecimalConverter
+}
+
+// NewVietnameseLanguage reads amounts in Vietnamese with dc, keeping its wording options
+func NewVietnameseLanguage(dc DecimalConverter) Language {
+	return vietnameseLanguage{dc: dc}
+}
+
+func (vietnameseLanguage) Code() string {
+	return "vi"
+}
+
+func (l vietnameseLanguage) ConvertAmount(amount Decimal, currency string, opts DecimalOptions) (string, error) {
+	if c, ok := LookupCurrency(currency); ok {
+		currency = c.Major
+	}
+	return l.dc.ConvertDecimal(amount, currency, opts)
+}
+
+var (
+	languageMu sync.RWMutex
+	languages  = map[string]Language{}
+)
+
+func init() {
+	for _, l := range []Language{
+		NewVietnameseLanguage(newTurboConverter(newOptions(nil))),
+		NewEnglish(EnglishOptions{}),
+	} {
+		languages[l.Code()] = l
+	}
+}
+
+// RegisterLanguage adds a language, replacing any language with the same code
+func RegisterLanguage(l Language) error {
+	if l == nil {
+		return invalidInput("", "missing language")
+	}
+	code := strings.ToLower(strings.TrimSpace(l.Code()))
+	if code == "" {
+		return invalidInput(code, "language has an empty code")
+	}
+
+	languageMu.Lock()
+	languages[code] = l
+	languageMu.Unlock()
+	return nil
+}
+
+// LookupLanguage finds a registered language by code, ignoring case
+func LookupLanguage(code string) (Language, bool) {
+	languageMu.RLock()
+	l, ok := languages[strings.ToLower(code)]
+	languageMu.RUnlock()
+	return l, ok
+}
+
+// Languages lists the registered language codes in order
+func Languages() []string {
+	languageMu.RLock()
+	codes := make([]string, 0, len(languages))
+	for code := range languages {
+		codes = append(codes, code)
+	}
+	languageMu.RUnlock()
+
+	sort.Strings(codes)
+	return codes
+}
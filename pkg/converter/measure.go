@@ -0,0 +1,172 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Unit is a measurement unit symbol and its Vietnamese reading
+type Unit struct {
+	Symbol string // as written in documents, e.g. "km²"
+	Word   string // as read, e.g. "ki-lô-mét vuông"
+}
+
+var (
+	unitMu sync.RWMutex
+	units  = map[string]Unit{}
+)
+
+func init() {
+	for _, u := range []Unit{
+		{"mm", "mi-li-mét"}, {"cm", "xăng-ti-mét"}, {"m", "mét"}, {"km", "ki-lô-mét"},
+		{"mm²", "mi-li-mét vuông"}, {"cm²", "xăng-ti-mét vuông"}, {"m²", "mét vuông"}, {"km²", "ki-lô-mét vuông"},
+		{"m³", "mét khối"}, {"ha", "héc-ta"},
+		{"mg", "mi-li-gam"}, {"g", "gam"}, {"kg", "ki-lô-gam"}, {"t", "tấn"}, {"tấn", "tấn"},
+		{"ml", "mi-li-lít"}, {"l", "lít"}, {"lít", "lít"},
+		{"°C", "độ C"}, {"°F", "độ F"}, {"%", "phần trăm"},
+		{"km/h", "ki-lô-mét trên giờ"}, {"m/s", "mét trên giây"},
+		{"kWh", "ki-lô-oát giờ"}, {"W", "oát"}, {"kW", "ki-lô-oát"}, {"V", "vôn"},
+		// ASCII spellings of the superscripts
+		{"m2", "mét vuông"}, {"km2", "ki-lô-mét vuông"}, {"m3", "mét khối"},
+	} {
+		units[u.Symbol] = u
+	}
+}
+
+// RegisterUnit adds a unit to the registry, replacing any unit with the same symbol
+func RegisterUnit(u Unit) error {
+	if u.Symbol == "" || u.Word == "" {
+		return invalidInput(u.Symbol, "unit needs both a symbol and a word")
+	}
+	unitMu.Lock()
+	units[u.Symbol] = u
+	unitMu.Unlock()
+	return nil
+}
+
+// LookupUnit finds a registered unit by symbol, falling back to a case-insensitive match
+func LookupUnit(symbol string) (Unit, bool) {
+	unitMu.RLock()
+	defer unitMu.RUnlock()
+
+	if u, ok := units[symbol]; ok {
+		return u, true
+	}
+	for s, u := range units {
+		if strings.EqualFold(s, symbol) {
+			return u, true
+		}
+	}
+	return Unit{}, false
+}
+
+// QuantityConverter is implemented by converters that read percentages,
+// fractions and measurements
+type QuantityConverter interface {
+	ConvertPercent(amount Decimal) (string, error)
+	ConvertFraction(numerator, denominator int64) (string, error)
+	ConvertMeasure(amount Decimal, unit string) (string, error)
+}
+
+// quantityOptions reads quantities mathematically, with "âm" for negatives
+var quantityOptions = DecimalOptions{
+	Fraction: FractionDigits,
+	Sign:     SignOptions{Style: SignWord},
+}
+
+// convertPercent implements ConvertPercent: 12,5 reads "mười hai phẩy năm phần trăm"
+func convertPercent(nc NumberConverter, amount Decimal) (string, error) {
+	return convertDecimal(nc, amount, "phần trăm", quantityOptions)
+}
+
+// convertMeasure implements ConvertMeasure: 25 km² reads "hai mươi lăm ki-lô-mét vuông"
+func convertMeasure(nc NumberConverter, amount Decimal, unit string) (string, error) {
+	u, ok := LookupUnit(unit)
+	if !ok {
+		return "", invalidInput(unit, "unknown unit %q", unit)
+	}
+	return convertDecimal(nc, amount, u.Word, quantityOptions)
+}
+
+// convertFraction implements ConvertFraction: 3/4 reads "ba phần tư".
+// The denominator 4 reads "tư", unlike the cardinal "bốn".
+func convertFraction(nc NumberConverter, numerator, denominator int64) (string, error) {
+	if denominator <= 0 {
+		return "", invalidInput(strconv.FormatInt(denominator, 10), "invalid fraction: denominator must be positive")
+	}
+
+	num, err := convertSigned(nc, numerator, "", SignOptions{Style: SignWord})
+	if err != nil {
+		return "", err
+	}
+
+	var den string
+	if denominator == 4 {
+		den = "tư"
+	} else if den, err = nc.ConvertWithCurrency(denominator, ""); err != nil {
+		return "", err
+	}
+
+	return num + " phần " + den, nil
+}
+
+// ConvertQuantity reads a quantity as written in a report: a percentage ("12,5%"),
+// a fraction ("3/4") or a number followed by a registered unit ("25 km²")
+func ConvertQuantity(qc QuantityConverter, text string) (string, error) {
+	text = strings.TrimSpace(text)
+
+	if num, den, ok := strings.Cut(text, "/"); ok && isInteger(num) && isInteger(den) {
+		n, err := strconv.ParseInt(strings.TrimSpace(num), 10, 64)
+		if err != nil {
+			return "", invalidInput(text, "invalid fraction %q: %v", text, err)
+		}
+		d, err := strconv.ParseInt(strings.TrimSpace(den), 10, 64)
+		if err != nil {
+			return "", invalidInput(text, "invalid fraction %q: %v", text, err)
+		}
+		return qc.ConvertFraction(n, d)
+	}
+
+	// The number ends where the unit starts
+	end := 0
+	for end < len(text) && strings.IndexByte("+-0123456789.,", text[end]) >= 0 {
+		end++
+	}
+	amount, err := ParseDecimal(text[:end])
+	if err != nil {
+		return "", err
+	}
+
+	unit := strings.TrimSpace(text[end:])
+	switch unit {
+	case "":
+		return convertDecimalQuantity(qc, amount)
+	case "%":
+		return qc.ConvertPercent(amount)
+	}
+	return qc.ConvertMeasure(amount, unit)
+}
+
+// convertDecimalQuantity reads a bare number the way quantities are read
+func convertDecimalQuantity(qc QuantityConverter, amount Decimal) (string, error) {
+	dc, ok := qc.(DecimalConverter)
+	if !ok {
+		return "", fmt.Errorf("converter cannot read decimal numbers")
+	}
+	return dc.ConvertDecimal(amount, "", quantityOptions)
+}
+
+func isInteger(s string) bool {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "-")
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package converter_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestConvertQuantity(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"12,5%", "mười hai phẩy năm phần trăm"},
+		{"100 %", "một trăm phần trăm"},
+		{"-2.5%", "âm hai phẩy năm phần trăm"},
+		{"3/4", "ba phần tư"},
+		{"1/2", "một phần hai"},
+		{"-1/3", "âm một phần ba"},
+		{"7/24", "bảy phần hai mươi tư"},
+		{"25 km²", "hai mươi lăm ki-lô-mét vuông"},
+		{"25km2", "hai mươi lăm ki-lô-mét vuông"},
+		{"1,5 kg", "một phẩy năm ki-lô-gam"},
+		{"-5 °C", "âm năm độ C"},
+		{"2 lít", "hai lít"},
+		{"0,05 ha", "không phẩy không năm héc-ta"},
+		{"12,5", "mười hai phẩy năm"},
+		{"60 KM/H", "sáu mươi ki-lô-mét trên giờ"},
+	}
+
+	for _, conv := range []converter.NumberConverter{converter.NewVietnameseConverter(), converter.NewTurboConverter()} {
+		qc := conv.(converter.QuantityConverter)
+		for _, tt := range tests {
+			got, err := converter.ConvertQuantity(qc, tt.text)
+			if err != nil {
+				t.Errorf("ConvertQuantity(%q) returned error: %v", tt.text, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("ConvertQuantity(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		}
+
+		for _, s := range []string{"", "3/0", "5 parsec", "abc", "1/-2"} {
+			if _, err := converter.ConvertQuantity(qc, s); err == nil {
+				t.Errorf("ConvertQuantity(%q) should fail", s)
+			}
+		}
+	}
+}
+
+func TestRegisterUnit(t *testing.T) {
+	if err := converter.RegisterUnit(converter.Unit{Symbol: "nm", Word: "na-nô-mét"}); err != nil {
+		t.Fatalf("RegisterUnit returned error: %v", err)
+	}
+	amount, _ := converter.ParseDecimal("3")
+	got, err := converter.NewTurboConverter().(converter.QuantityConverter).ConvertMeasure(amount, "nm")
+	if err != nil || got != "ba na-nô-mét" {
+		t.Errorf("ConvertMeasure(3, nm) = %q, %v", got, err)
+	}
+
+	if err := converter.RegisterUnit(converter.Unit{Symbol: "x"}); err == nil {
+		t.Errorf("RegisterUnit without a word should fail")
+	}
+}
@@ -0,0 +1,165 @@
+package converter
+
+// Lexicon holds the words a converter reads numbers with
+type Lexicon struct {
+	Digits        [10]string `json:"digits" yaml:"digits"`                   // không, một, hai, ..., chín
+	Ten           string     `json:"ten" yaml:"ten"`                         // mười
+	Tens          string     `json:"tens" yaml:"tens"`                       // mươi, as in hai mươi
+	Hundred       string     `json:"hundred" yaml:"hundred"`                 // trăm
+	OddZero       string     `json:"odd_zero" yaml:"odd_zero"`               // lẻ or linh, as in một trăm lẻ năm
+	OneAfterTens  string     `json:"one_after_tens" yaml:"one_after_tens"`   // mốt, as in hai mươi mốt
+	FourAfterTens string     `json:"four_after_tens" yaml:"four_after_tens"` // tư or bốn, as in hai mươi tư
+	FiveAfterTen  string     `json:"five_after_ten" yaml:"five_after_ten"`   // lăm, as in mười lăm
+	FiveAfterTens string     `json:"five_after_tens" yaml:"five_after_tens"` // lăm or nhăm, as in hai mươi lăm
+	Thousand      string     `json:"thousand" yaml:"thousand"`               // nghìn or ngàn
+	Million       string     `json:"million" yaml:"million"`                 // triệu
+	Billion       string     `json:"billion" yaml:"billion"`                 // tỷ or tỉ
+}
+
+// Dialect selects a regional profile for the lexicon
+type Dialect int
+
+const (
+	// DialectNorthern reads "nghìn", "lẻ" and "tỷ"
+	DialectNorthern Dialect = iota
+	// DialectSouthern reads "ngàn", "linh" and "tỉ"
+	DialectSouthern
+)
+
+// NorthernLexicon returns the lexicon used by default
+func NorthernLexicon() Lexicon {
+	return Lexicon{
+		Digits: [10]string{
+			"không", "một", "hai", "ba", "bốn", "năm", "sáu", "bảy", "tám", "chín",
+		},
+		Ten:           "mười",
+		Tens:          "mươi",
+		Hundred:       "trăm",
+		OddZero:       "lẻ",
+		OneAfterTens:  "mốt",
+		FourAfterTens: "tư",
+		FiveAfterTen:  "lăm",
+		FiveAfterTens: "lăm",
+		Thousand:      "nghìn",
+		Million:       "triệu",
+		Billion:       "tỷ",
+	}
+}
+
+// SouthernLexicon returns the lexicon used in the South
+func SouthernLexicon() Lexicon {
+	lex := NorthernLexicon()
+	lex.OddZero = "linh"
+	lex.Thousand = "ngàn"
+	lex.Billion = "tỉ"
+	return lex
+}
+
+// Option configures a converter built by NewVietnameseConverter or NewTurboConverter
+type Option func(*options)
+
+type options struct {
+	dialect    Dialect
+	lexicon    *Lexicon // replaces the dialect profile when set
+	fourAsBon  bool
+	fiveAsNham bool
+
+	secondAsNhi bool // ordinal 2 as "thứ nhì"
+
+	encoding Encoding // character form of the output
+	style    Style    // formal or colloquial amounts
+
+	zeros ZeroPolicy // how zeros inside a number are read
+}
+
+// WithDialect selects the regional lexicon profile
+func WithDialect(d Dialect) Option {
+	return func(o *options) {
+		o.dialect = d
+		o.lexicon = nil
+	}
+}
+
+// WithLexicon replaces the dialect profile with a complete custom lexicon
+func WithLexicon(lex Lexicon) Option {
+	return func(o *options) {
+		o.lexicon = &lex
+	}
+}
+
+// WithFourAsBon reads 24, 34, ... as "hai mươi bốn" instead of "hai mươi tư"
+func WithFourAsBon() Option {
+	return func(o *options) {
+		o.fourAsBon = true
+	}
+}
+
+// WithFiveAsNham reads 25, 35, ... as "hai mươi nhăm" instead of "hai mươi lăm"
+func WithFiveAsNham() Option {
+	return func(o *options) {
+		o.fiveAsNham = true
+	}
+}
+
+// Configurable is implemented by converters that can derive a copy of
+// themselves with extra options, e.g. per HTTP request
+type Configurable interface {
+	WithOptions(opts ...Option) NumberConverter
+}
+
+// Settings is what a list of options resolves to, for converters
+// implemented outside this package
+type Settings struct {
+	Lexicon  Lexicon    // the dialect profile or custom lexicon with the variant flags applied
+	Zeros    ZeroPolicy // how zeros inside a number are read
+	Encoding Encoding   // character form of the output
+	Style    Style      // formal or colloquial amounts
+}
+
+// Resolve applies opts and returns the settings a converter should read numbers with
+func Resolve(opts ...Option) Settings {
+	o := newOptions(opts)
+	return Settings{
+		Lexicon:  o.buildLexicon(),
+		Zeros:    o.zeros,
+		Encoding: o.encoding,
+		Style:    o.style,
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// buildLexicon resolves the dialect profile and variant flags into the final lexicon
+func (o options) buildLexicon() Lexicon {
+	var lex Lexicon
+	switch {
+	case o.lexicon != nil:
+		lex = *o.lexicon
+	case o.dialect == DialectSouthern:
+		lex = SouthernLexicon()
+	default:
+		lex = NorthernLexicon()
+	}
+
+	if o.fourAsBon {
+		lex.FourAfterTens = lex.Digits[4]
+	}
+	if o.fiveAsNham {
+		lex.FiveAfterTens = "nhăm"
+	}
+	return lex
+}
+
+// with returns a copy of o with more options applied
+func (o options) with(opts []Option) options {
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,50 @@
+package converter_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestDialectOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []converter.Option
+		number int64
+		want   string
+	}{
+		{"northern", nil, 1205034, "một triệu hai trăm lẻ năm nghìn không trăm ba mươi tư đồng"},
+		{"southern", []converter.Option{converter.WithDialect(converter.DialectSouthern)}, 1205034,
+			"một triệu hai trăm linh năm ngàn không trăm ba mươi tư đồng"},
+		{"southern billions", []converter.Option{converter.WithDialect(converter.DialectSouthern)}, 2000000000000,
+			"hai ngàn tỉ đồng"},
+		{"bốn", []converter.Option{converter.WithFourAsBon()}, 24, "hai mươi bốn đồng"},
+		{"nhăm", []converter.Option{converter.WithFiveAsNham()}, 35015, "ba mươi nhăm nghìn không trăm mười lăm đồng"},
+		{"combined", []converter.Option{converter.WithFiveAsNham(), converter.WithDialect(converter.DialectSouthern)}, 25000,
+			"hai mươi nhăm ngàn đồng"},
+	}
+
+	constructors := map[string]func(...converter.Option) converter.NumberConverter{
+		"original": converter.NewVietnameseConverter,
+		"turbo":    converter.NewTurboConverter,
+	}
+
+	for engine, newConverter := range constructors {
+		for _, tt := range tests {
+			got, err := newConverter(tt.opts...).Convert(tt.number)
+			if err != nil {
+				t.Errorf("%s/%s: Convert(%d) returned error: %v", engine, tt.name, tt.number, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%s/%s: Convert(%d) = %q, want %q", engine, tt.name, tt.number, got, tt.want)
+			}
+
+			// Deriving from a default converter must give the same result
+			derived := newConverter().(converter.Configurable).WithOptions(tt.opts...)
+			if got, _ := derived.Convert(tt.number); got != tt.want {
+				t.Errorf("%s/%s: WithOptions Convert(%d) = %q, want %q", engine, tt.name, tt.number, got, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package converter
+
+import "strconv"
+
+// OrdinalConverter is implemented by converters that read ordinal numbers,
+// as used for clauses and installments: "thứ nhất", "thứ tư", "thứ mười một"
+type OrdinalConverter interface {
+	ConvertOrdinal(number int64) (string, error)
+}
+
+// WithSecondAsNhi reads the ordinal 2 as "thứ nhì" instead of "thứ hai"
+func WithSecondAsNhi() Option {
+	return func(o *options) {
+		o.secondAsNhi = true
+	}
+}
+
+// convertOrdinal implements ConvertOrdinal on top of a converter's cardinal reading.
+// Only 1, 2 and 4 have their own ordinal words; larger numbers keep the
+// cardinal reading, so 14 is "thứ mười bốn" and 21 is "thứ hai mươi mốt".
+func convertOrdinal(nc NumberConverter, number int64, o options) (string, error) {
+	if number < 1 {
+		return "", &Error{Kind: ErrOutOfRange, Input: strconv.FormatInt(number, 10), Limit: "1", Msg: "ordinal numbers start at 1"}
+	}
+
+	switch number {
+	case 1:
+		return "thứ nhất", nil
+	case 2:
+		if o.secondAsNhi {
+			return "thứ nhì", nil
+		}
+	case 4:
+		return "thứ tư", nil
+	}
+
+	text, err := nc.ConvertWithCurrency(number, "")
+	if err != nil {
+		return "", err
+	}
+	return "thứ " + text, nil
+}
@@ -0,0 +1,54 @@
+package converter_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestConvertOrdinal(t *testing.T) {
+	tests := []struct {
+		number int64
+		opts   []converter.Option
+		want   string
+	}{
+		{1, nil, "thứ nhất"},
+		{2, nil, "thứ hai"},
+		{2, []converter.Option{converter.WithSecondAsNhi()}, "thứ nhì"},
+		{3, nil, "thứ ba"},
+		{4, nil, "thứ tư"},
+		{4, []converter.Option{converter.WithFourAsBon()}, "thứ tư"},
+		{10, nil, "thứ mười"},
+		{11, nil, "thứ mười một"},
+		{14, nil, "thứ mười bốn"},
+		{21, nil, "thứ hai mươi mốt"},
+		{24, nil, "thứ hai mươi tư"},
+		{101, nil, "thứ một trăm lẻ một"},
+		{1000, nil, "thứ một nghìn"},
+	}
+
+	constructors := map[string]func(...converter.Option) converter.NumberConverter{
+		"original": converter.NewVietnameseConverter,
+		"turbo":    converter.NewTurboConverter,
+	}
+
+	for engine, newConverter := range constructors {
+		for _, tt := range tests {
+			oc := newConverter(tt.opts...).(converter.OrdinalConverter)
+			got, err := oc.ConvertOrdinal(tt.number)
+			if err != nil {
+				t.Errorf("%s: ConvertOrdinal(%d) returned error: %v", engine, tt.number, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%s: ConvertOrdinal(%d) = %q, want %q", engine, tt.number, got, tt.want)
+			}
+		}
+
+		for _, n := range []int64{0, -3} {
+			if _, err := newConverter().(converter.OrdinalConverter).ConvertOrdinal(n); err == nil {
+				t.Errorf("%s: ConvertOrdinal(%d) should fail", engine, n)
+			}
+		}
+	}
+}
@@ -0,0 +1,397 @@
+package converter
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ParsedAmount is the result of reading Vietnamese number words back into a number
+type ParsedAmount struct {
+	Value    *big.Int
+	Currency string // trailing unit words such as "đồng", empty when absent
+}
+
+// ParseError reports where in the input the reading failed
+type ParseError struct {
+	Offset int    // byte offset of the offending word in the input
+	Word   string // the offending word, empty at end of input
+	Msg    string
+}
+
+func (e *ParseError) Error() string {
+	if e.Word == "" {
+		return fmt.Sprintf("parse error at offset %d: %s", e.Offset, e.Msg)
+	}
+	return fmt.Sprintf("parse error at offset %d (%q): %s", e.Offset, e.Word, e.Msg)
+}
+
+// Unwrap makes parse errors match ErrInvalidInput
+func (e *ParseError) Unwrap() error {
+	return ErrInvalidInput
+}
+
+// digitWords maps every spelling of a digit the converters emit,
+// including the Southern and positional variants
+var digitWords = map[string]int{
+	"không": 0,
+	"một":   1, "mốt": 1,
+	"hai": 2,
+	"ba":  3,
+	"bốn": 4, "tư": 4,
+	"năm": 5, "lăm": 5, "nhăm": 5,
+	"sáu": 6,
+	"bảy": 7, "bẩy": 7,
+	"tám":  8,
+	"chín": 9,
+}
+
+// scaleWords maps the scale words below the recursive "tỷ"
+var scaleWords = map[string]int{
+	"nghìn": 1000, "ngàn": 1000,
+	"triệu": 1000000,
+}
+
+func isBillionWord(w string) bool {
+	return w == "tỷ" || w == "tỉ"
+}
+
+func isNumberWord(w string) bool {
+	if _, ok := digitWords[w]; ok {
+		return true
+	}
+	if _, ok := scaleWords[w]; ok {
+		return true
+	}
+	switch w {
+	case "mười", "mươi", "trăm", "lẻ", "linh", halfWord:
+		return true
+	}
+	return isBillionWord(w)
+}
+
+type parseToken struct {
+	word   string // lower-cased, surrounding punctuation removed
+	raw    string
+	offset int
+}
+
+// shortDigit reads a digit in a colloquial shortened group, where 5 may be "rưỡi"
+func shortDigit(w string) (int, bool) {
+	if w == halfWord {
+		return 5, true
+	}
+	d, ok := digitWords[w]
+	return d, ok && d > 0
+}
+
+// Parse reads Vietnamese number words such as "một tỷ không trăm lẻ năm triệu đồng"
+// back into an int64, ignoring any trailing currency words
+func Parse(text string, opts ...Option) (int64, error) {
+	amount, err := ParseAmount(text, opts...)
+	if err != nil {
+		return 0, err
+	}
+	if !amount.Value.IsInt64() {
+		return 0, &ParseError{Offset: 0, Msg: "number does not fit in int64"}
+	}
+	return amount.Value.Int64(), nil
+}
+
+// ParseAmount reads Vietnamese number words back into an arbitrarily large integer
+// and reports the trailing currency words. It accepts everything the converters
+// emit, including lẻ/linh, mốt, tư, lăm, nghìn/ngàn, tỷ/tỉ, a leading "âm",
+// accounting parentheses and the colloquial style: "hai mốt", "một trăm rưỡi".
+//
+// One reading is ambiguous: a lone digit after the last scale word is the
+// colloquial shortened group, "một triệu hai" being 1.200.000, unless opts
+// select a ZeroPolicy with OmitZeroHundreds and not StyleColloquial, which
+// reads "một nghìn hai" as 1.002.
+func ParseAmount(text string, opts ...Option) (ParsedAmount, error) {
+	o := newOptions(opts)
+	shortTails := o.style == StyleColloquial || !o.zeros.OmitZeroHundreds
+
+	tokens := tokenizeWords(text)
+
+	negative := false
+	if len(tokens) > 0 && tokens[0].word == "âm" {
+		negative = true
+		tokens = tokens[1:]
+	}
+	if trimmed := strings.TrimSpace(text); strings.HasPrefix(trimmed, "(") && strings.HasSuffix(trimmed, ")") {
+		negative = true
+	}
+
+	end := 0
+	for end < len(tokens) && isNumberWord(tokens[end].word) {
+		end++
+	}
+	if end == 0 {
+		if len(tokens) == 0 {
+			return ParsedAmount{}, &ParseError{Offset: len(text), Msg: "expected a number"}
+		}
+		return ParsedAmount{}, &ParseError{Offset: tokens[0].offset, Word: tokens[0].raw, Msg: "expected a number"}
+	}
+
+	value, err := parseNumberTokens(tokens[:end], shortTails)
+	if err != nil {
+		return ParsedAmount{}, err
+	}
+	if negative {
+		value.Neg(value)
+	}
+
+	var currency []string
+	for _, tok := range tokens[end:] {
+		currency = append(currency, tok.raw)
+	}
+
+	return ParsedAmount{Value: value, Currency: strings.Join(currency, " ")}, nil
+}
+
+// tokenizeWords splits text on whitespace, remembering the byte offset of each word
+func tokenizeWords(text string) []parseToken {
+	var tokens []parseToken
+	for i := 0; i < len(text); {
+		if isSpace(text[i]) {
+			i++
+			continue
+		}
+		start := i
+		for i < len(text) && !isSpace(text[i]) {
+			i++
+		}
+		raw := text[start:i]
+
+		// Drop punctuation such as the e-invoice terminator "./." and accounting parentheses
+		trimmed := strings.TrimLeft(raw, "(")
+		offset := start + len(raw) - len(trimmed)
+		trimmed = strings.TrimRight(trimmed, ".,;:!?)/")
+		if trimmed == "" {
+			continue
+		}
+
+		tokens = append(tokens, parseToken{
+			word:   strings.ToLower(trimmed),
+			raw:    trimmed,
+			offset: offset,
+		})
+	}
+	return tokens
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+// parseNumberTokens reads a run of number words. "tỷ" chains recursively, so
+// the words split into blocks below one tỷ, each followed by its run of "tỷ":
+// "một nghìn tỷ tỷ không trăm mười một" is 1000 × 10^18 + 11. shortTails reads
+// a lone digit after the last scale word as a colloquial shortened group.
+func parseNumberTokens(tokens []parseToken, shortTails bool) (*big.Int, error) {
+	total := new(big.Int)
+	billion := big.NewInt(1000000000)
+	lastPower := -1
+
+	for start := 0; start < len(tokens); {
+		end := start
+		for end < len(tokens) && !isBillionWord(tokens[end].word) {
+			end++
+		}
+		power := 0
+		for end+power < len(tokens) && isBillionWord(tokens[end+power].word) {
+			power++
+		}
+
+		if end == start {
+			return nil, &ParseError{Offset: tokens[start].offset, Word: tokens[start].raw, Msg: "scale word without a number"}
+		}
+		if lastPower >= 0 && power >= lastPower {
+			return nil, &ParseError{Offset: tokens[start].offset, Word: tokens[start].raw, Msg: "scales out of order"}
+		}
+
+		// Only the last block may end in a shortened group; after a single
+		// "tỷ" the group stands for hundreds of triệu: "hai tỷ tư"
+		var tail int64
+		if shortTails && power == 0 {
+			tail = 1
+			if lastPower == 1 {
+				tail = billion.Int64()
+			}
+		}
+
+		block, err := parseBlock(tokens[start:end], start == 0, tail)
+		if err != nil {
+			return nil, err
+		}
+		if block == 0 && power > 0 {
+			return nil, &ParseError{Offset: tokens[start].offset, Word: tokens[start].raw, Msg: "zero before a scale word"}
+		}
+
+		value := big.NewInt(block)
+		for i := 0; i < power; i++ {
+			value.Mul(value, billion)
+		}
+		total.Add(total, value)
+
+		lastPower = power
+		start = end + power
+	}
+
+	return total, nil
+}
+
+// parseBlock reads a number below one tỷ: up to three groups joined by triệu
+// and nghìn. leading is true for the first block of the number. tail is
+// non-zero when the block may end in a colloquial shortened group, a lone
+// digit read as hundreds of the scale before it: "một triệu hai" is
+// 1.200.000. It is that scale when it comes before the block, else 1.
+func parseBlock(tokens []parseToken, leading bool, tail int64) (int64, error) {
+	var total int64
+	lastScale := 1 << 30
+
+	for start := 0; start < len(tokens); {
+		end := start
+		for end < len(tokens) {
+			if _, ok := scaleWords[tokens[end].word]; ok {
+				break
+			}
+			end++
+		}
+
+		scale := 1
+		if end < len(tokens) {
+			scale = scaleWords[tokens[end].word]
+		}
+		if end == start {
+			return 0, &ParseError{Offset: tokens[start].offset, Word: tokens[start].raw, Msg: "scale word without a number"}
+		}
+		if scale >= lastScale {
+			return 0, &ParseError{Offset: tokens[end].offset, Word: tokens[end].raw, Msg: "scales out of order"}
+		}
+
+		before := tail
+		if start > 0 {
+			before = int64(lastScale)
+		}
+		if d, ok := shortDigit(tokens[start].word); ok && tail > 0 && before >= 1000 && end == len(tokens) && end-start == 1 {
+			total += int64(d) * before / 10
+			break
+		}
+
+		group, err := parseGroup(tokens[start:end], leading && start == 0)
+		if err != nil {
+			return 0, err
+		}
+		total += int64(group) * int64(scale)
+
+		lastScale = scale
+		start = end
+		if end < len(tokens) {
+			start++
+		}
+	}
+
+	return total, nil
+}
+
+// parseGroup reads a three-digit group such as "không trăm lẻ năm" or "chín mươi tư".
+// Groups after the leading one may start with lẻ/linh, as house styles that
+// omit "không trăm" read them: "một nghìn lẻ năm". The colloquial forms drop
+// "mươi", "hai mốt" being 21, and read a lone digit after a non-zero hundreds
+// digit as the tens: "một trăm hai" is 120 and "một trăm rưỡi" 150.
+func parseGroup(tokens []parseToken, leading bool) (int, error) {
+	pos := 0
+	unexpected := func(msg string) error {
+		if pos >= len(tokens) {
+			last := tokens[len(tokens)-1]
+			return &ParseError{Offset: last.offset + len(last.raw), Msg: msg}
+		}
+		return &ParseError{Offset: tokens[pos].offset, Word: tokens[pos].raw, Msg: msg}
+	}
+	digitAt := func(i int) (int, bool) {
+		if i >= len(tokens) {
+			return 0, false
+		}
+		d, ok := digitWords[tokens[i].word]
+		return d, ok
+	}
+
+	value := 0
+	hasHundreds := false
+
+	// Hundreds: "<digit> trăm", where the digit may be "không"
+	if d, ok := digitAt(pos); ok && pos+1 < len(tokens) && tokens[pos+1].word == "trăm" {
+		value = d * 100
+		hasHundreds = true
+		pos += 2
+	}
+
+	if pos < len(tokens) {
+		word := tokens[pos].word
+		switch {
+		case word == "lẻ" || word == "linh":
+			if !hasHundreds && (leading || pos > 0) {
+				return 0, unexpected("lẻ/linh must follow trăm")
+			}
+			pos++
+			d, ok := digitAt(pos)
+			if !ok || d == 0 {
+				return 0, unexpected("expected a digit after lẻ/linh")
+			}
+			value += d
+			pos++
+
+		case word == "mười":
+			value += 10
+			pos++
+			if d, ok := digitAt(pos); ok && d > 0 && tokens[pos].word != "mốt" {
+				value += d
+				pos++
+			}
+
+		case word == halfWord && hasHundreds && value > 0:
+			value += 50
+			pos++
+
+		default:
+			d, ok := digitAt(pos)
+			if !ok {
+				return 0, unexpected("expected a digit")
+			}
+			u, shortTens := digitAt(pos + 1)
+			shortTens = shortTens && u > 0 && d >= 2
+			switch {
+			case pos+1 < len(tokens) && tokens[pos+1].word == "mươi":
+				if d < 2 {
+					return 0, unexpected("tens must be between hai mươi and chín mươi")
+				}
+				value += d * 10
+				pos += 2
+				if u, ok := digitAt(pos); ok && u > 0 {
+					value += u
+					pos++
+				}
+			case hasHundreds && value > 0 && !shortTens && word != "lăm" && word != "nhăm":
+				// "một trăm hai", the colloquial 120, and "một trăm mốt";
+				// "không trăm chín" is how inner groups are read without lẻ
+				value += d * 10
+				pos++
+			case word == "mốt" || word == "lăm" || word == "nhăm":
+				return 0, unexpected("this form only follows mươi or mười")
+			case shortTens:
+				// "hai mốt", the colloquial "hai mươi mốt"
+				value += d*10 + u
+				pos += 2
+			default:
+				value += d
+				pos++
+			}
+		}
+	}
+
+	if pos < len(tokens) {
+		return 0, unexpected("unexpected word")
+	}
+	return value, nil
+}
@@ -0,0 +1,96 @@
+package converter_test
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		text     string
+		want     string
+		currency string
+	}{
+		{"không đồng", "0", "đồng"},
+		{"một tỷ không trăm lẻ năm triệu đồng", "1005000000", "đồng"},
+		{"một tỷ không trăm linh năm triệu", "1005000000", ""},
+		{"hai mươi mốt nghìn ba trăm mười lăm", "21315", ""},
+		{"năm mươi tư ngàn tỉ đồng", "54000000000000", "đồng"},
+		{"một nghìn không trăm hai mươi tỷ", "1020000000000", ""},
+		{"một nghìn tỷ tỷ không trăm mười một", "1000000000000000000011", ""},
+		{"Một triệu hai trăm nghìn đồng chẵn./.", "1200000", "đồng chẵn"},
+		{"âm một triệu đồng", "-1000000", "đồng"},
+		{"(hai trăm năm mươi nghìn đồng)", "-250000", "đồng"},
+		{"ba mươi lăm đô la Mỹ", "35", "đô la Mỹ"},
+		{"hai nghìn không trăm chín", "2009", ""},
+	}
+
+	for _, tt := range tests {
+		got, err := converter.ParseAmount(tt.text)
+		if err != nil {
+			t.Errorf("ParseAmount(%q) returned error: %v", tt.text, err)
+			continue
+		}
+		if got.Value.String() != tt.want || got.Currency != tt.currency {
+			t.Errorf("ParseAmount(%q) = %s %q, want %s %q", tt.text, got.Value, got.Currency, tt.want, tt.currency)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	// at is the word the error must point to
+	tests := []struct {
+		text string
+		at   string
+	}{
+		{"", ""},
+		{"đồng", "đồng"},
+		{"một trăm lăm", "lăm"},
+		{"một nghìn hai triệu", "triệu"},
+		{"hai tỷ ba tỷ", "ba"},
+		{"mười mốt", "mốt"},
+		{"lẻ năm", "lẻ"},
+	}
+
+	for _, tt := range tests {
+		_, err := converter.ParseAmount(tt.text)
+		var pe *converter.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("ParseAmount(%q) error = %v, want *ParseError", tt.text, err)
+			continue
+		}
+		if want := strings.Index(tt.text, tt.at); pe.Offset != want || pe.Word != tt.at {
+			t.Errorf("ParseAmount(%q) error at %d %q, want %d %q", tt.text, pe.Offset, pe.Word, want, tt.at)
+		}
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	numbers := []int64{0, 1, 10, 15, 21, 101, 110, 1001, 1000000, 1005000000, 9223372036854775807}
+	for i := 0; i < 2000; i++ {
+		numbers = append(numbers, rng.Int63n(1<<uint(rng.Intn(62)+1)))
+	}
+
+	for _, conv := range []converter.NumberConverter{converter.NewVietnameseConverter(), converter.NewTurboConverter()} {
+		for _, n := range numbers {
+			text, err := conv.Convert(n)
+			if err != nil {
+				t.Fatalf("Convert(%d): %v", n, err)
+			}
+			got, err := converter.Parse(text)
+			if err != nil {
+				t.Errorf("Parse(%q) returned error: %v", text, err)
+				continue
+			}
+			if got != n {
+				t.Errorf("Parse(Convert(%d)) = %d (%q)", n, got, text)
+			}
+		}
+	}
+}
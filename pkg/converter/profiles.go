@@ -0,0 +1,185 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Validate checks that every word of the lexicon is set and trimmed
+func (lex Lexicon) Validate() error {
+	for d, word := range lex.Digits {
+		if err := checkWord(fmt.Sprintf("digits[%d]", d), word); err != nil {
+			return err
+		}
+	}
+	for _, field := range []struct{ name, word string }{
+		{"ten", lex.Ten},
+		{"tens", lex.Tens},
+		{"hundred", lex.Hundred},
+		{"odd_zero", lex.OddZero},
+		{"one_after_tens", lex.OneAfterTens},
+		{"four_after_tens", lex.FourAfterTens},
+		{"five_after_ten", lex.FiveAfterTen},
+		{"five_after_tens", lex.FiveAfterTens},
+		{"thousand", lex.Thousand},
+		{"million", lex.Million},
+		{"billion", lex.Billion},
+	} {
+		if err := checkWord(field.name, field.word); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkWord(name, word string) error {
+	if word == "" {
+		return invalidInput(word, "lexicon: %s is empty", name)
+	}
+	if strings.TrimSpace(word) != word {
+		return invalidInput(word, "lexicon: %s %q has surrounding spaces", name, word)
+	}
+	return nil
+}
+
+// ParseLexiconProfiles reads named lexicon profiles from a JSON or YAML
+// document of the form
+//
+//	profiles:
+//	  southern_bank:
+//	    base: southern   # optional starting profile: northern (default) or southern
+//	    thousand: ngàn
+//
+// Each profile only lists the words it changes. Every resulting lexicon is validated.
+func ParseLexiconProfiles(data []byte, format string) (map[string]Lexicon, error) {
+	raw := map[string]func(*Lexicon) error{}
+	bases := map[string]string{}
+
+	switch strings.ToLower(format) {
+	case "json":
+		var doc struct {
+			Profiles map[string]json.RawMessage `json:"profiles"`
+		}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, invalidInput("", "lexicon profiles: %v", err)
+		}
+		for name, msg := range doc.Profiles {
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(msg, &fields); err != nil {
+				return nil, invalidInput(name, "lexicon profile %s: %v", name, err)
+			}
+			for key := range fields {
+				if err := checkProfileKey(name, key); err != nil {
+					return nil, err
+				}
+			}
+			if msg, ok := fields["base"]; ok {
+				var base string
+				if err := json.Unmarshal(msg, &base); err != nil {
+					return nil, invalidInput(name, "lexicon profile %s: base: %v", name, err)
+				}
+				bases[name] = base
+			}
+			raw[name] = func(lex *Lexicon) error {
+				return json.NewDecoder(bytes.NewReader(msg)).Decode(lex)
+			}
+		}
+	case "yaml", "yml":
+		var doc struct {
+			Profiles map[string]yaml.Node `yaml:"profiles"`
+		}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, invalidInput("", "lexicon profiles: %v", err)
+		}
+		for name, node := range doc.Profiles {
+			var head struct {
+				Base string `yaml:"base"`
+			}
+			if err := node.Decode(&head); err != nil {
+				return nil, invalidInput(name, "lexicon profile %s: %v", name, err)
+			}
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if err := checkProfileKey(name, node.Content[i].Value); err != nil {
+					return nil, err
+				}
+			}
+			bases[name] = head.Base
+			raw[name] = func(lex *Lexicon) error {
+				return node.Decode(lex)
+			}
+		}
+	default:
+		return nil, invalidInput(format, "lexicon profiles: unknown format %q", format)
+	}
+
+	profiles := make(map[string]Lexicon, len(raw))
+	for name, decode := range raw {
+		if err := checkProfileName(name); err != nil {
+			return nil, err
+		}
+
+		var lex Lexicon
+		switch bases[name] {
+		case "", "northern":
+			lex = NorthernLexicon()
+		case "southern":
+			lex = SouthernLexicon()
+		default:
+			return nil, invalidInput(bases[name], "lexicon profile %s: unknown base %q", name, bases[name])
+		}
+
+		// Decoding over the base keeps every word the profile does not list
+		if err := decode(&lex); err != nil {
+			return nil, invalidInput(name, "lexicon profile %s: %v", name, err)
+		}
+		if err := lex.Validate(); err != nil {
+			return nil, invalidInput(name, "lexicon profile %s: %v", name, err)
+		}
+		profiles[name] = lex
+	}
+	return profiles, nil
+}
+
+// LexiconFormat guesses the profile format from a file name: "yaml" for
+// .yaml and .yml, "json" otherwise
+func LexiconFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return "yaml"
+	}
+	return "json"
+}
+
+// profileKeys are the keys a profile may set: the lexicon's tags plus "base"
+var profileKeys = map[string]bool{
+	"base": true, "digits": true, "ten": true, "tens": true, "hundred": true,
+	"odd_zero": true, "one_after_tens": true, "four_after_tens": true,
+	"five_after_ten": true, "five_after_tens": true,
+	"thousand": true, "million": true, "billion": true,
+}
+
+// checkProfileKey rejects misspelled words, which would otherwise be ignored silently
+func checkProfileKey(name, key string) error {
+	if !profileKeys[key] {
+		return invalidInput(key, "lexicon profile %s: unknown key %q", name, key)
+	}
+	return nil
+}
+
+// checkProfileName accepts names usable as an API parameter: a-z, 0-9, "_" and "-"
+func checkProfileName(name string) error {
+	if name == "" {
+		return invalidInput(name, "lexicon profile with an empty name")
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+			return invalidInput(name, "lexicon profile %q: names use a-z, 0-9, '_' and '-'", name)
+		}
+	}
+	return nil
+}
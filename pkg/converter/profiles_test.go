@@ -0,0 +1,91 @@
+package converter_test
+
+import (
+	"errors"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestParseLexiconProfiles(t *testing.T) {
+	docs := map[string]string{
+		"json": `{"profiles": {
+			"bank": {"base": "southern", "billion": "tỉ"},
+			"linh": {"odd_zero": "linh", "five_after_tens": "nhăm"}
+		}}`,
+		"yaml": `
+profiles:
+  bank:
+    base: southern
+    billion: tỉ
+  linh:
+    odd_zero: linh
+    five_after_tens: nhăm
+`,
+	}
+
+	for format, doc := range docs {
+		profiles, err := converter.ParseLexiconProfiles([]byte(doc), format)
+		if err != nil {
+			t.Fatalf("%s: ParseLexiconProfiles: %v", format, err)
+		}
+
+		tests := []struct {
+			profile string
+			n       int64
+			want    string
+		}{
+			{"bank", 1_001_000_000, "một tỉ không trăm một triệu đồng"},
+			{"bank", 1_000, "một ngàn đồng"},
+			{"linh", 105, "một trăm linh năm đồng"},
+			{"linh", 1_025, "một nghìn không trăm hai mươi nhăm đồng"},
+		}
+		for _, tt := range tests {
+			lex, ok := profiles[tt.profile]
+			if !ok {
+				t.Fatalf("%s: profile %q missing", format, tt.profile)
+			}
+			conv := converter.NewVietnameseConverter(converter.WithLexicon(lex))
+			if got, err := conv.Convert(tt.n); err != nil || got != tt.want {
+				t.Errorf("%s/%s: Convert(%d) = %q, %v, want %q", format, tt.profile, tt.n, got, err, tt.want)
+			}
+		}
+	}
+}
+
+func TestParseLexiconProfilesInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		doc    string
+	}{
+		{"syntax", "json", `{"profiles": `},
+		{"format", "toml", `profiles = {}`},
+		{"empty word", "json", `{"profiles": {"p": {"thousand": ""}}}`},
+		{"spaces", "yaml", "profiles:\n  p:\n    million: ' triệu'\n"},
+		{"unknown key", "yaml", "profiles:\n  p:\n    thousnd: ngàn\n"},
+		{"unknown base", "json", `{"profiles": {"p": {"base": "central"}}}`},
+		{"name", "json", `{"profiles": {"My Profile": {}}}`},
+		{"digit count", "json", `{"profiles": {"p": {"digits": ["không"]}}}`},
+	}
+
+	for _, tt := range tests {
+		_, err := converter.ParseLexiconProfiles([]byte(tt.doc), tt.format)
+		if !errors.Is(err, converter.ErrInvalidInput) {
+			t.Errorf("%s: error = %v, want ErrInvalidInput", tt.name, err)
+		}
+	}
+}
+
+func TestLexiconFormat(t *testing.T) {
+	for path, want := range map[string]string{
+		"lexicons.yaml": "yaml",
+		"lexicons.YML":  "yaml",
+		"lexicons.json": "json",
+		"lexicons":      "json",
+	} {
+		if got := converter.LexiconFormat(path); got != want {
+			t.Errorf("LexiconFormat(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package converter
+
+import (
+	"math"
+)
+
+// SignStyle selects how negative amounts are written
+type SignStyle int
+
+const (
+	// SignReject refuses negative amounts, like Convert does
+	SignReject SignStyle = iota
+	// SignWord puts a word in front of the amount: "âm một triệu đồng"
+	SignWord
+	// SignAccounting wraps the amount in parentheses: "(một triệu đồng)"
+	SignAccounting
+)
+
+// SignOptions configures how credit notes, refunds and reversals are read
+type SignOptions struct {
+	Style SignStyle
+	// Prefix replaces "âm" in SignWord style, e.g. "trừ" or "giảm"
+	Prefix string
+}
+
+// SignedConverter is implemented by converters that can read negative amounts
+type SignedConverter interface {
+	ConvertSigned(number int64, currency string, opts SignOptions) (string, error)
+}
+
+// convertSigned implements ConvertSigned on top of a converter's unsigned reading
+func convertSigned(nc NumberConverter, number int64, currency string, opts SignOptions) (string, error) {
+	if number >= 0 {
+		return nc.ConvertWithCurrency(number, currency)
+	}
+	if opts.Style == SignReject {
+		return "", ErrNegative
+	}
+
+	var text string
+	var err error
+	if bc, ok := nc.(BigConverter); ok {
+		text, err = bc.ConvertUint64(Magnitude(number), currency)
+	} else if number == math.MinInt64 {
+		return "", errTooLarge()
+	} else {
+		text, err = nc.ConvertWithCurrency(-number, currency)
+	}
+	if err != nil {
+		return "", err
+	}
+	return applySign(text, opts), nil
+}
+
+// Magnitude returns the absolute value of n as a uint64, which unlike
+// negating n also holds for math.MinInt64
+func Magnitude(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
+
+// applySign marks an already converted amount as negative
+func applySign(text string, opts SignOptions) string {
+	switch opts.Style {
+	case SignAccounting:
+		return "(" + text + ")"
+	case SignWord:
+		prefix := opts.Prefix
+		if prefix == "" {
+			prefix = "âm"
+		}
+		return prefix + " " + text
+	}
+	return text
+}
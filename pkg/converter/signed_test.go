@@ -0,0 +1,95 @@
+package converter_test
+
+import (
+	"math"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestConvertSigned(t *testing.T) {
+	tests := []struct {
+		number int64
+		opts   converter.SignOptions
+		want   string
+	}{
+		{-1000000, converter.SignOptions{Style: converter.SignWord}, "âm một triệu đồng"},
+		{-1000000, converter.SignOptions{Style: converter.SignWord, Prefix: "trừ"}, "trừ một triệu đồng"},
+		{-250000, converter.SignOptions{Style: converter.SignAccounting}, "(hai trăm năm mươi nghìn đồng)"},
+		{1000000, converter.SignOptions{Style: converter.SignWord}, "một triệu đồng"},
+		{0, converter.SignOptions{Style: converter.SignAccounting}, "không đồng"},
+	}
+
+	engines := map[string]converter.NumberConverter{
+		"original": converter.NewVietnameseConverter(),
+		"turbo":    converter.NewTurboConverter(),
+	}
+
+	for name, engine := range engines {
+		sc, ok := engine.(converter.SignedConverter)
+		if !ok {
+			t.Fatalf("%s converter does not implement SignedConverter", name)
+		}
+		for _, tt := range tests {
+			got, err := sc.ConvertSigned(tt.number, "đồng", tt.opts)
+			if err != nil {
+				t.Errorf("%s: ConvertSigned(%d) returned error: %v", name, tt.number, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%s: ConvertSigned(%d) = %q, want %q", name, tt.number, got, tt.want)
+			}
+		}
+
+		if _, err := sc.ConvertSigned(-5, "đồng", converter.SignOptions{}); err == nil {
+			t.Errorf("%s: ConvertSigned(-5) without a sign style expected error", name)
+		}
+	}
+}
+
+func TestConvertDecimalNegative(t *testing.T) {
+	opts := converter.DefaultDecimalOptions()
+	opts.Sign = converter.SignOptions{Style: converter.SignWord}
+	dc := converter.NewTurboConverter().(converter.DecimalConverter)
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"-1.500,25", "âm một nghìn năm trăm đồng hai mươi lăm xu"},
+		{"-0,001", "không đồng"},
+	}
+
+	for _, tt := range tests {
+		d, err := converter.ParseDecimal(tt.input)
+		if err != nil {
+			t.Fatalf("ParseDecimal(%q): %v", tt.input, err)
+		}
+		got, err := dc.ConvertDecimal(d, "đồng", opts)
+		if err != nil {
+			t.Errorf("ConvertDecimal(%s) returned error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ConvertDecimal(%s) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestMagnitude(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want uint64
+	}{
+		{0, 0},
+		{42, 42},
+		{-42, 42},
+		{math.MaxInt64, math.MaxInt64},
+		{math.MinInt64, 1 << 63},
+	}
+	for _, tt := range tests {
+		if got := converter.Magnitude(tt.n); got != tt.want {
+			t.Errorf("Magnitude(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
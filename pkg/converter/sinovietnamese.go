@@ -0,0 +1,83 @@
+package converter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SinoVietnameseConverter is implemented by converters that read numbers with
+// Hán-Việt numerals, as written in temple, genealogy and ceremony documents:
+// "nhất vạn nhị thiên tam bách tứ thập ngũ"
+type SinoVietnameseConverter interface {
+	ConvertSinoVietnamese(number int64) (string, error)
+}
+
+var (
+	sinoDigits = [10]string{"linh", "nhất", "nhị", "tam", "tứ", "ngũ", "lục", "thất", "bát", "cửu"}
+	// sinoPlaces names the places inside a four-digit group, units first
+	sinoPlaces = [4]string{"", "thập", "bách", "thiên"}
+	// sinoScales names each group of four digits: 10^4 is "vạn", 10^8 "ức", 10^12 "triệu", 10^16 "kinh"
+	sinoScales = [5]string{"", "vạn", "ức", "triệu", "kinh"}
+)
+
+// splitIntoMyriads splits number into groups of four digits, most significant
+// first, since Hán-Việt scales step by 10,000 rather than 1,000
+func splitIntoMyriads(number uint64) []int {
+	var groups []int
+	for number > 0 {
+		groups = append([]int{int(number % 10000)}, groups...)
+		number /= 10000
+	}
+	return groups
+}
+
+// convertSinoVietnamese implements ConvertSinoVietnamese. A run of zeros between
+// non-zero digits reads a single "linh", also across groups: 100,005 is
+// "thập vạn linh ngũ". A leading 1 in the tens place is left unread, so 15 is
+// "thập ngũ" while 115 is "nhất bách nhất thập ngũ".
+func convertSinoVietnamese(number int64) (string, error) {
+	if number < 0 {
+		return "", &Error{Kind: ErrNegative, Input: strconv.FormatInt(number, 10), Msg: "Hán-Việt numerals are read for non-negative numbers only"}
+	}
+
+	groups := splitIntoMyriads(uint64(number))
+	if len(groups) == 0 {
+		return sinoDigits[0], nil
+	}
+
+	var words []string
+	zero := false // a zero digit is waiting for the next non-zero one
+	for i, group := range groups {
+		scaleIndex := len(groups) - i - 1
+		for place := 3; place >= 0; place-- {
+			digit := group
+			for p := 0; p < place; p++ {
+				digit /= 10
+			}
+			digit %= 10
+
+			if digit == 0 {
+				zero = zero || len(words) > 0
+				continue
+			}
+			if zero {
+				words = append(words, sinoDigits[0])
+				zero = false
+			}
+			if digit != 1 || place != 1 || len(words) > 0 {
+				words = append(words, sinoDigits[digit])
+			}
+			if place > 0 {
+				words = append(words, sinoPlaces[place])
+			}
+		}
+
+		// Zeros at the end of a group are covered by its scale word
+		if group != 0 && scaleIndex > 0 {
+			words = append(words, sinoScales[scaleIndex])
+			zero = false
+		}
+	}
+
+	return strings.Join(words, " "), nil
+}
@@ -0,0 +1,69 @@
+package converter_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestConvertSinoVietnamese(t *testing.T) {
+	tests := []struct {
+		number int64
+		want   string
+	}{
+		{0, "linh"},
+		{1, "nhất"},
+		{4, "tứ"},
+		{10, "thập"},
+		{15, "thập ngũ"},
+		{20, "nhị thập"},
+		{21, "nhị thập nhất"},
+		{100, "nhất bách"},
+		{105, "nhất bách linh ngũ"},
+		{115, "nhất bách nhất thập ngũ"},
+		{1000, "nhất thiên"},
+		{1005, "nhất thiên linh ngũ"},
+		{1050, "nhất thiên linh ngũ thập"},
+		{10_000, "nhất vạn"},
+		{12_345, "nhất vạn nhị thiên tam bách tứ thập ngũ"},
+		{100_000, "thập vạn"},
+		{100_005, "thập vạn linh ngũ"},
+		{23_000_500, "nhị thiên tam bách vạn linh ngũ bách"},
+		{23_005_000, "nhị thiên tam bách vạn ngũ thiên"},
+		{100_000_000, "nhất ức"},
+		{100_000_005, "nhất ức linh ngũ"},
+		{100_100_000, "nhất ức linh nhất thập vạn"},
+		{1_000_000_000_000, "nhất triệu"},
+		{math.MaxInt64, "cửu bách nhị thập nhị kinh tam thiên tam bách thất thập nhị triệu linh tam bách lục thập bát ức ngũ thiên tứ bách thất thập thất vạn ngũ thiên bát bách linh thất"},
+	}
+
+	constructors := map[string]func(...converter.Option) converter.NumberConverter{
+		"original": converter.NewVietnameseConverter,
+		"turbo":    converter.NewTurboConverter,
+	}
+
+	for engine, newConverter := range constructors {
+		sc := newConverter().(converter.SinoVietnameseConverter)
+		for _, tt := range tests {
+			got, err := sc.ConvertSinoVietnamese(tt.number)
+			if err != nil {
+				t.Errorf("%s: ConvertSinoVietnamese(%d) returned error: %v", engine, tt.number, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%s: ConvertSinoVietnamese(%d) = %q, want %q", engine, tt.number, got, tt.want)
+			}
+		}
+
+		if _, err := sc.ConvertSinoVietnamese(-1); !errors.Is(err, converter.ErrNegative) {
+			t.Errorf("%s: ConvertSinoVietnamese(-1) error = %v, want ErrNegative", engine, err)
+		}
+
+		ascii := newConverter(converter.WithEncoding(converter.EncodingASCII)).(converter.SinoVietnameseConverter)
+		if got, _ := ascii.ConvertSinoVietnamese(24); got != "nhi thap tu" {
+			t.Errorf("%s: ASCII ConvertSinoVietnamese(24) = %q", engine, got)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package converter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SSMLOptions controls the SSML written for text-to-speech engines
+type SSMLOptions struct {
+	Lang       string        // xml:lang of the <speak> element
+	GroupBreak time.Duration // pause after each group's scale words; 0 for none
+}
+
+// DefaultSSMLOptions speaks Vietnamese with a short pause between groups
+func DefaultSSMLOptions() SSMLOptions {
+	return SSMLOptions{
+		Lang:       "vi-VN",
+		GroupBreak: 150 * time.Millisecond,
+	}
+}
+
+// RenderSSML writes an explained reading as an SSML document, with a <break>
+// after each three-digit group and its scale words; the currency comes last.
+// The words are already spelled out, so they are spoken as plain text:
+//
+//	<speak ...>một triệu<break time="150ms"/>không trăm năm nghìn đồng</speak>
+func RenderSSML(e Explanation, opts SSMLOptions) string {
+	var b strings.Builder
+	openSpeak(&b, opts)
+
+	var group []string
+	groups := 0
+	flush := func() {
+		if len(group) == 0 {
+			return
+		}
+		if groups > 0 {
+			if opts.GroupBreak > 0 {
+				fmt.Fprintf(&b, `<break time="%dms"/>`, opts.GroupBreak.Milliseconds())
+			} else {
+				b.WriteByte(' ')
+			}
+		}
+		xml.EscapeText(&b, []byte(strings.Join(group, " ")))
+		group = group[:0]
+		groups++
+	}
+
+	for i, tok := range e.Tokens {
+		if tok.Kind == TokenCurrency {
+			flush()
+			b.WriteByte(' ')
+			xml.EscapeText(&b, []byte(tok.Word))
+			continue
+		}
+
+		group = append(group, tok.Word)
+		// A group ends after its last scale word, e.g. "nghìn tỷ"
+		if tok.Kind == TokenScale && (i+1 == len(e.Tokens) || e.Tokens[i+1].Kind != TokenScale) {
+			flush()
+		}
+	}
+	flush()
+
+	b.WriteString(`</speak>`)
+	return b.String()
+}
+
+// RenderSSMLText wraps a reading with no group structure, such as a decimal
+// amount or a formatted cheque line, in an SSML document
+func RenderSSMLText(text string, opts SSMLOptions) string {
+	var b strings.Builder
+	openSpeak(&b, opts)
+	xml.EscapeText(&b, []byte(text))
+	b.WriteString(`</speak>`)
+	return b.String()
+}
+
+func openSpeak(b *strings.Builder, opts SSMLOptions) {
+	b.WriteString(`<speak version="1.1" xmlns="http://www.w3.org/2001/10/synthesis"`)
+	if opts.Lang != "" {
+		b.WriteString(` xml:lang="`)
+		xml.EscapeText(b, []byte(opts.Lang))
+		b.WriteByte('"')
+	}
+	b.WriteByte('>')
+}
@@ -0,0 +1,50 @@
+package converter_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestRenderSSML(t *testing.T) {
+	explainer := converter.NewVietnameseConverter().(converter.Explainer)
+	const speak = `<speak version="1.1" xmlns="http://www.w3.org/2001/10/synthesis" xml:lang="vi-VN">`
+
+	tests := []struct {
+		digits   string
+		currency string
+		opts     converter.SSMLOptions
+		want     string
+	}{
+		{"1005000", "đồng", converter.DefaultSSMLOptions(), speak +
+			`một triệu<break time="150ms"/>không trăm năm nghìn đồng</speak>`},
+		{"21", "", converter.DefaultSSMLOptions(), speak + `hai mươi mốt</speak>`},
+		// Chained scale words stay with their group; empty groups add no break
+		{"1000000000005", "", converter.SSMLOptions{}, `<speak version="1.1" xmlns="http://www.w3.org/2001/10/synthesis">` +
+			`một nghìn tỷ không trăm năm</speak>`},
+		{"7", "R&D", converter.DefaultSSMLOptions(), speak + `bảy R&amp;D</speak>`},
+	}
+
+	for _, tt := range tests {
+		explanation, err := explainer.Explain(tt.digits, tt.currency)
+		if err != nil {
+			t.Fatalf("Explain(%q): %v", tt.digits, err)
+		}
+		got := converter.RenderSSML(explanation, tt.opts)
+		if got != tt.want {
+			t.Errorf("RenderSSML(%s) =\n%s\nwant\n%s", tt.digits, got, tt.want)
+		}
+		if err := xml.Unmarshal([]byte(got), new(struct{})); err != nil {
+			t.Errorf("RenderSSML(%s) is not well-formed XML: %v", tt.digits, err)
+		}
+	}
+}
+
+func TestRenderSSMLText(t *testing.T) {
+	got := converter.RenderSSMLText("Một triệu đồng <chẵn>.", converter.DefaultSSMLOptions())
+	if !strings.HasSuffix(got, `>Một triệu đồng &lt;chẵn&gt;.</speak>`) {
+		t.Errorf("RenderSSMLText = %s", got)
+	}
+}
@@ -140,8 +140,13 @@ type TestSuite struct {
 }
 
 func NewTestSuite() *TestSuite {
+	return NewTestSuiteFor(converter.NewVietnameseConverter())
+}
+
+// NewTestSuiteFor runs the dataset against any NumberConverter implementation
+func NewTestSuiteFor(conv converter.NumberConverter) *TestSuite {
 	return &TestSuite{
-		converter: converter.NewVietnameseConverter(),
+		converter: conv,
 		loader:    NewTestDataLoader(),
 	}
 }
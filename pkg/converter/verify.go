@@ -0,0 +1,232 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// VerifyStatus classifies how an amount in words compares with its digits
+type VerifyStatus string
+
+const (
+	VerifyExact      VerifyStatus = "exact"      // the text is the converter's reading
+	VerifyVariant    VerifyStatus = "variant"    // the same reading up to tolerated dialect variants: linh/lẻ, ngàn/nghìn, ...
+	VerifyWording    VerifyStatus = "wording"    // the right number in other wording, such as "một nghìn không trăm lẻ năm"
+	VerifyMismatch   VerifyStatus = "mismatch"   // a different number
+	VerifyUnreadable VerifyStatus = "unreadable" // no number reading at all
+)
+
+// Variant is one word of the text that differs from the reading only by dialect
+type Variant struct {
+	Expected string `json:"expected"` // "lẻ"
+	Found    string `json:"found"`    // "linh"
+	Offset   int    `json:"offset"`   // byte offset of the word in the text
+}
+
+// GroupDiff is one three-digit group the text reads differently
+type GroupDiff struct {
+	Scale          string `json:"scale"`           // the group's scale words, "triệu" or "nghìn tỷ"; empty for the units
+	ExpectedDigits string `json:"expected_digits"` // "005"
+	FoundDigits    string `json:"found_digits"`    // "050"
+	Expected       string `json:"expected"`        // the reading of the expected group, empty when silent: "không trăm năm"
+	Found          string `json:"found"`           // the reading of the group the text has: "không trăm năm mươi"
+}
+
+// Verification is the result of checking an amount in words against its digits
+type Verification struct {
+	// Match is true when the text is the reading, up to tolerated dialect variants
+	Match    bool         `json:"match"`
+	Status   VerifyStatus `json:"status"`
+	Expected string       `json:"expected"`           // the converter's reading of the digits
+	Parsed   string       `json:"parsed,omitempty"`   // the number the text reads, when it parses
+	Currency string       `json:"currency,omitempty"` // words after the number: "đồng chẵn"
+	Variants []Variant    `json:"variants,omitempty"`
+	Groups   []GroupDiff  `json:"groups,omitempty"` // the wrong groups, highest first
+	// Detail and Offset locate the problem in unreadable text
+	Detail string `json:"detail,omitempty"`
+	Offset *int   `json:"offset,omitempty"`
+}
+
+// dialectVariants pairs the words either dialect may use for the same digit or scale
+var dialectVariants = map[[2]string]bool{
+	{"lẻ", "linh"}:    true,
+	{"nghìn", "ngàn"}: true,
+	{"tỷ", "tỉ"}:      true,
+	{"tư", "bốn"}:     true,
+	{"lăm", "nhăm"}:   true,
+	{"bảy", "bẩy"}:    true,
+}
+
+func isDialectVariant(a, b string) bool {
+	return dialectVariants[[2]string{a, b}] || dialectVariants[[2]string{b, a}]
+}
+
+// Verify checks that text reads the non-negative integer given as decimal
+// digits the way nc reads it. Capitalization, punctuation such as "./." and
+// the words after the number are ignored; the currency is reported but not
+// checked. On a mismatch the wrong groups are listed when nc is an Explainer.
+func Verify(nc NumberConverter, digits string, text string) (Verification, error) {
+	if _, err := splitDigitGroups(digits); err != nil {
+		return Verification{}, err
+	}
+	digits = trimLeadingZeros(digits)
+
+	expected, err := readInteger(nc, digits, "")
+	if err != nil {
+		return Verification{}, err
+	}
+	result := Verification{Expected: expected}
+
+	text = Encode(text, EncodingNFC)
+	amount, err := ParseAmount(text)
+	if err != nil {
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			return Verification{}, err
+		}
+		offset := pe.Offset
+		result.Status = VerifyUnreadable
+		result.Detail = pe.Error()
+		result.Offset = &offset
+		return result, nil
+	}
+	result.Parsed = amount.Value.String()
+	result.Currency = amount.Currency
+
+	want, _ := new(big.Int).SetString(digits, 10)
+	// A lone digit after a scale word is the colloquial shortened group unless
+	// the house style omits "không trăm": take the reading the digits support
+	if amount.Value.Cmp(want) != 0 {
+		omit := WithZeroPolicy(ZeroPolicy{OmitZeroHundreds: true})
+		if alt, err := ParseAmount(text, omit); err == nil && alt.Value.Cmp(want) == 0 {
+			amount = alt
+			result.Parsed = amount.Value.String()
+		}
+	}
+	if amount.Value.Cmp(want) != 0 {
+		result.Status = VerifyMismatch
+		if explainer, ok := nc.(Explainer); ok {
+			result.Groups, err = groupDiffs(explainer, digits, new(big.Int).Abs(amount.Value).String())
+			if err != nil {
+				return Verification{}, err
+			}
+		}
+		return result, nil
+	}
+
+	// The same number: compare the words themselves
+	wantWords := strings.Fields(Encode(expected, EncodingNFC))
+	var found []parseToken
+	for _, tok := range tokenizeWords(text) {
+		if !isNumberWord(tok.word) {
+			break
+		}
+		found = append(found, tok)
+	}
+
+	result.Status = VerifyExact
+	if len(found) != len(wantWords) {
+		result.Status = VerifyWording
+		return result, nil
+	}
+	for i, tok := range found {
+		switch {
+		case sameWord(tok.word, wantWords[i]):
+		case isDialectVariant(tok.word, wantWords[i]):
+			result.Status = VerifyVariant
+			result.Variants = append(result.Variants, Variant{Expected: wantWords[i], Found: tok.raw, Offset: tok.offset})
+		default:
+			result.Status = VerifyWording
+			result.Variants = nil
+			return result, nil
+		}
+	}
+	result.Match = true
+	return result, nil
+}
+
+// sameWord reports whether a word of the text is want, which an ASCII
+// reading has written without diacritics
+func sameWord(word, want string) bool {
+	return word == want || Encode(word, EncodingASCII) == want
+}
+
+// groupDiffs lists the groups of foundDigits that differ from the expected
+// digits, both read with the explainer's own wording
+func groupDiffs(explainer Explainer, digits, foundDigits string) ([]GroupDiff, error) {
+	groups, _ := splitDigitGroups(digits)
+	foundGroups, _ := splitDigitGroups(foundDigits)
+	expected, err := explainer.Explain(digits, "")
+	if err != nil {
+		return nil, err
+	}
+	found, err := explainer.Explain(foundDigits, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []GroupDiff
+	for k := max(len(groups), len(foundGroups)) - 1; k >= 0; k-- {
+		want, got := groupAt(groups, k), groupAt(foundGroups, k)
+		if want == got {
+			continue
+		}
+		scale, err := scaleName(explainer, k)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, GroupDiff{
+			Scale:          scale,
+			ExpectedDigits: fmt.Sprintf("%03d", want),
+			FoundDigits:    fmt.Sprintf("%03d", got),
+			Expected:       groupWords(expected.Tokens, groups, k),
+			Found:          groupWords(found.Tokens, foundGroups, k),
+		})
+	}
+	return diffs, nil
+}
+
+// groupAt returns the group at scale k of groups ordered from the highest scale down
+func groupAt(groups []int, k int) int {
+	if k >= len(groups) {
+		return 0
+	}
+	return groups[len(groups)-1-k]
+}
+
+// groupWords joins the digit words an explanation read for the group at scale k
+func groupWords(tokens []Token, groups []int, k int) string {
+	if k >= len(groups) {
+		return ""
+	}
+	// Token positions index the digits without leading zeros
+	digits := len(fmt.Sprint(groups[0])) + 3*(len(groups)-1)
+	end := digits - 3*k
+	start := max(end-3, 0)
+
+	var words []string
+	for _, tok := range tokens {
+		if tok.Kind != TokenScale && tok.Kind != TokenCurrency && tok.Start >= start && tok.End <= end {
+			words = append(words, tok.Word)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// scaleName returns the scale words after the group at scaleIndex, "nghìn" or
+// "triệu tỷ", as the scale words of the explainer's reading of 10^(3·scaleIndex)
+func scaleName(explainer Explainer, scaleIndex int) (string, error) {
+	explanation, err := explainer.Explain("1"+strings.Repeat("000", scaleIndex), "")
+	if err != nil {
+		return "", err
+	}
+	var words []string
+	for _, tok := range explanation.Tokens {
+		if tok.Kind == TokenScale {
+			words = append(words, tok.Word)
+		}
+	}
+	return strings.Join(words, " "), nil
+}
@@ -0,0 +1,116 @@
+package converter_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestVerify(t *testing.T) {
+	conv := converter.NewVietnameseConverter()
+
+	tests := []struct {
+		digits string
+		text   string
+		status converter.VerifyStatus
+		match  bool
+	}{
+		{"1005000", "một triệu không trăm năm nghìn đồng", converter.VerifyExact, true},
+		{"1005000", "Một triệu không trăm năm nghìn đồng chẵn./.", converter.VerifyExact, true},
+		{"1105000", "một triệu một trăm linh năm ngàn đồng", converter.VerifyVariant, true},
+		{"24", "hai mươi bốn", converter.VerifyVariant, true},
+		{"1005", "một nghìn không trăm lẻ năm đồng", converter.VerifyWording, false},
+		{"21", "hai mươi một", converter.VerifyWording, false},
+		{"1005000", "một triệu không trăm năm mươi nghìn đồng", converter.VerifyMismatch, false},
+		{"1005000", "đồng", converter.VerifyUnreadable, false},
+		// "năm" after the last scale is read as units when the digits say so
+		{"1005", "một nghìn năm", converter.VerifyWording, false},
+	}
+
+	for _, tt := range tests {
+		got, err := converter.Verify(conv, tt.digits, tt.text)
+		if err != nil {
+			t.Errorf("Verify(%s, %q) returned error: %v", tt.digits, tt.text, err)
+			continue
+		}
+		if got.Status != tt.status || got.Match != tt.match {
+			t.Errorf("Verify(%s, %q) = %s, match %v, want %s, match %v", tt.digits, tt.text, got.Status, got.Match, tt.status, tt.match)
+		}
+	}
+
+	if _, err := converter.Verify(conv, "12a", "mười hai"); !errors.Is(err, converter.ErrInvalidInput) {
+		t.Errorf("Verify(12a) error = %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestVerifyDiagnosis(t *testing.T) {
+	conv := converter.NewVietnameseConverter()
+
+	got, err := converter.Verify(conv, "1250005000", "một tỷ hai trăm năm mươi triệu không trăm lẻ năm nghìn đồng")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != converter.VerifyWording || got.Match || got.Parsed != "1250005000" {
+		t.Fatalf("Verify = %+v, want the right number in other wording", got)
+	}
+
+	got, err = converter.Verify(conv, "1250005000", "một tỷ hai trăm năm mươi triệu không trăm năm mươi nghìn đồng")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []converter.GroupDiff{{
+		Scale:          "nghìn",
+		ExpectedDigits: "005",
+		FoundDigits:    "050",
+		Expected:       "không trăm năm",
+		Found:          "không trăm năm mươi",
+	}}
+	if got.Status != converter.VerifyMismatch || !reflect.DeepEqual(got.Groups, want) {
+		t.Errorf("Verify groups = %+v, want %+v", got.Groups, want)
+	}
+	if got.Expected != "một tỷ hai trăm năm mươi triệu không trăm năm nghìn" || got.Currency != "đồng" {
+		t.Errorf("Verify = %+v", got)
+	}
+
+	// A missing scale shows as two wrong groups
+	got, _ = converter.Verify(conv, "2000000", "hai nghìn đồng")
+	want = []converter.GroupDiff{
+		{Scale: "triệu", ExpectedDigits: "002", FoundDigits: "000", Expected: "hai", Found: ""},
+		{Scale: "nghìn", ExpectedDigits: "000", FoundDigits: "002", Expected: "", Found: "hai"},
+	}
+	if !reflect.DeepEqual(got.Groups, want) {
+		t.Errorf("Verify(2000000) groups = %+v, want %+v", got.Groups, want)
+	}
+
+	got, _ = converter.Verify(conv, "1105000", "một triệu một trăm linh năm ngàn")
+	variants := []converter.Variant{
+		{Expected: "lẻ", Found: "linh", Offset: 26},
+		{Expected: "nghìn", Found: "ngàn", Offset: 36},
+	}
+	if !reflect.DeepEqual(got.Variants, variants) {
+		t.Errorf("Verify variants = %+v, want %+v", got.Variants, variants)
+	}
+
+	got, _ = converter.Verify(conv, "5", "năm trăm lẻ")
+	if got.Status != converter.VerifyUnreadable || got.Offset == nil {
+		t.Errorf("Verify(unreadable) = %+v, want an offset", got)
+	}
+}
+
+func TestVerifySouthern(t *testing.T) {
+	conv := converter.NewVietnameseConverter(converter.WithDialect(converter.DialectSouthern))
+	got, err := converter.Verify(conv, "1105000", "một triệu một trăm linh năm ngàn đồng")
+	if err != nil || got.Status != converter.VerifyExact {
+		t.Errorf("Verify = %+v, %v, want exact", got, err)
+	}
+}
+
+func TestVerifyZeroPolicy(t *testing.T) {
+	conv := converter.NewVietnameseConverter(converter.WithZeroPolicy(converter.ZeroPolicy{OmitZeroHundreds: true}))
+	got, err := converter.Verify(conv, "1005", "một nghìn năm đồng")
+	if err != nil || got.Status != converter.VerifyExact || got.Parsed != "1005" {
+		t.Errorf("Verify = %+v, %v, want exact", got, err)
+	}
+}
@@ -1,8 +1,10 @@
 package converter
 
 import (
-	"fmt"
-	"strings"
+	"io"
+	"math/big"
+	"strconv"
+	"time"
 )
 
 type NumberConverter interface {
@@ -15,25 +17,45 @@ type vietnameseConverter struct {
 	tens      []string
 	scales    []string
 	zeroWords map[int]string
+	lex       Lexicon
+	opts      options
+	formal    *vietnameseConverter // itself unless the style is colloquial
 }
 
-func NewVietnameseConverter() NumberConverter {
-	return &vietnameseConverter{
-		units: []string{
-			"", "một", "hai", "ba", "bốn", "năm", "sáu", "bảy", "tám", "chín",
-		},
-		tens: []string{
-			"", "mười", "hai mươi", "ba mươi", "bốn mươi", "năm mươi",
-			"sáu mươi", "bảy mươi", "tám mươi", "chín mươi",
-		},
-		scales: []string{
-			"", "nghìn", "triệu", "tỷ", "nghìn tỷ", "triệu tỷ", "tỷ tỷ",
-		},
+func NewVietnameseConverter(opts ...Option) NumberConverter {
+	return newVietnameseConverter(newOptions(opts))
+}
+
+func newVietnameseConverter(o options) *vietnameseConverter {
+	lex := o.buildLexicon()
+
+	vc := &vietnameseConverter{
+		units:  []string{""},
+		tens:   []string{"", lex.Ten},
+		scales: []string{"", lex.Thousand, lex.Million, lex.Billion},
 		zeroWords: map[int]string{
-			1: "lẻ",
-			2: "không trăm",
+			1: lex.OddZero,
+			2: lex.Digits[0] + " " + lex.Hundred,
 		},
+		lex:  lex,
+		opts: o,
 	}
+	vc.units = append(vc.units, lex.Digits[1:]...)
+	for d := 2; d <= 9; d++ {
+		vc.tens = append(vc.tens, lex.Digits[d]+" "+lex.Tens)
+	}
+
+	vc.formal = vc
+	if o.style != StyleFormal {
+		vc.formal = newVietnameseConverter(o.formal())
+	}
+
+	return vc
+}
+
+// WithOptions returns a copy of the converter with opts applied on top of its own
+func (vc *vietnameseConverter) WithOptions(opts ...Option) NumberConverter {
+	return newVietnameseConverter(vc.opts.with(opts))
 }
 
 func (vc *vietnameseConverter) Convert(number int64) (string, error) {
@@ -42,77 +64,190 @@ func (vc *vietnameseConverter) Convert(number int64) (string, error) {
 
 func (vc *vietnameseConverter) ConvertWithCurrency(number int64, currency string) (string, error) {
 	if number < 0 {
-		return "", fmt.Errorf("negative numbers not supported")
+		return "", ErrNegative
 	}
 
-	if number > 999999999999999 {
-		return "", fmt.Errorf("number too large (max: 999,999,999,999,999)")
+	return vc.ConvertUint64(uint64(number), currency)
+}
+
+// ConvertUint64 converts the full unsigned 64-bit range
+func (vc *vietnameseConverter) ConvertUint64(number uint64, currency string) (string, error) {
+	return vc.opts.encodeResult(vc.readGroups(vc.splitIntoGroups(number), currency), nil)
+}
+
+// AppendConvert appends the reading of number to dst and returns the extended buffer
+func (vc *vietnameseConverter) AppendConvert(dst []byte, number int64, currency string) ([]byte, error) {
+	text, err := vc.ConvertWithCurrency(number, currency)
+	if err != nil {
+		return dst, err
 	}
+	return append(dst, text...), nil
+}
 
-	if number == 0 {
-		if currency != "" {
-			return "không " + currency, nil
-		}
-		return "không", nil
+// WriteConvert writes the reading of number to w
+func (vc *vietnameseConverter) WriteConvert(w io.Writer, number int64, currency string) (int, error) {
+	return writeConverted(vc, w, number, currency)
+}
+
+// ConvertBig converts an arbitrarily large non-negative integer
+func (vc *vietnameseConverter) ConvertBig(number *big.Int, currency string) (string, error) {
+	if number == nil || number.Sign() < 0 {
+		return "", ErrNegative
+	}
+	return vc.ConvertDigits(number.String(), currency)
+}
+
+// ConvertDigits converts a non-negative integer given as a string of decimal digits
+func (vc *vietnameseConverter) ConvertDigits(digits string, currency string) (string, error) {
+	groups, err := splitDigitGroups(digits)
+	if err != nil {
+		return "", err
 	}
+	return vc.opts.encodeResult(vc.readGroups(groups, currency), nil)
+}
 
-	groups := vc.splitIntoGroups(number)
+// readGroups reads three-digit groups ordered from the highest scale down
+func (vc *vietnameseConverter) readGroups(groups []int, currency string) string {
+	return Explanation{Tokens: vc.groupTokens(groups, currency)}.Text()
+}
 
-	var parts []string
-	groupCount := len(groups)
+// Explain converts a non-negative integer given as decimal digits and
+// reports which digits produced each word
+func (vc *vietnameseConverter) Explain(digits string, currency string) (Explanation, error) {
+	groups, err := splitDigitGroups(digits)
+	if err != nil {
+		return Explanation{}, err
+	}
 
-	// Handle single digit case
-	if groupCount == 1 && groups[0] < 10 {
-		result := vc.units[groups[0]]
-		if currency != "" {
-			result += " " + currency
-		}
-		return result, nil
+	tokens := vc.groupTokens(groups, currency)
+	for i := range tokens {
+		tokens[i].Word = Encode(tokens[i].Word, vc.opts.encoding)
 	}
+	return Explanation{Digits: trimLeadingZeros(digits), Tokens: tokens}, nil
+}
 
-	for i, group := range groups {
-		scaleIndex := groupCount - i - 1
-		if group == 0 {
-			// Only read zero group if it's the lowest group (units)
-			if i != groupCount-1 {
-				continue
-			}
-		}
+// groupTokens reads three-digit groups ordered from the highest scale down,
+// no groups being zero. Token positions index the digits without leading zeros.
+func (vc *vietnameseConverter) groupTokens(groups []int, currency string) []Token {
+	var tokens []Token
+	if len(groups) == 0 {
+		tokens = append(tokens, Token{Word: vc.lex.Digits[0], Kind: TokenUnit, Start: 0, End: 1})
+	}
 
-		groupText := vc.convertThreeDigitGroup(group, scaleIndex, i == 0)
+	tailAt, tail, digit := -1, tailNone, 0
+	if vc.opts.style == StyleColloquial {
+		tailAt, tail, digit = colloquialTail(len(groups), func(k int) int { return groups[len(groups)-1-k] })
+		tailAt = len(groups) - 1 - tailAt
+	}
 
-		if groupText != "" && group != 0 {
+	end := 0
+	for i, group := range groups {
+		start := end
+		if i == 0 {
+			end = len(strconv.Itoa(group))
+		} else {
+			end += 3
+		}
 
-			if scaleIndex > 0 && scaleIndex < len(vc.scales) {
-				groupText += " " + vc.scales[scaleIndex]
-			}
-			parts = append(parts, groupText)
-		} else if group == 0 && i == groupCount-1 {
-			// Only add zero for the lowest group if all others are zero
-			if len(parts) == 0 {
-				parts = append(parts, "không")
+		// Zero groups are silent unless the policy reads them; the scale words
+		// of the groups around them carry the place
+		if group == 0 {
+			scaleIndex := len(groups) - i - 1
+			if vc.opts.zeros.ReadsEmptyGroup(scaleIndex, func(k int) int { return groups[len(groups)-1-k] }) {
+				tokens = append(tokens,
+					Token{Word: vc.lex.Digits[0], Kind: TokenConnector, Start: start, End: end},
+					Token{Word: vc.scales[scaleIndex%3], Kind: TokenScale, Start: start, End: end})
 			}
+			continue
 		}
-	}
 
-	if len(parts) == 0 {
-		if currency != "" {
-			return "không " + currency, nil
+		switch {
+		case i == tailAt && tail == tailAfterScale:
+			// The scale before carries the place: "một triệu hai"
+			tokens = append(tokens, Token{Word: vc.lex.shortWord(digit), Kind: TokenUnit, Start: end - 3, End: end - 2})
+			continue
+		case i == tailAt && tail == tailAfterHundred:
+			tokens = append(tokens,
+				Token{Word: vc.units[group/100] + " " + vc.lex.Hundred, Kind: TokenHundred, Start: end - 3, End: end - 2},
+				Token{Word: vc.lex.shortWord(digit), Kind: TokenUnit, Start: end - 2, End: end - 1})
+		default:
+			tokens = vc.appendGroupTokens(tokens, group, i == 0, end)
+		}
+		if scaleIndex := len(groups) - i - 1; scaleIndex > 0 {
+			tokens = vc.appendScaleTokens(tokens, scaleIndex, groups[i+1:], start, end)
 		}
-		return "không", nil
 	}
 
-	result := strings.Join(parts, " ")
-	result = vc.normalizeVietnamese(result)
-
 	if currency != "" {
-		result += " " + currency
+		tokens = append(tokens, Token{Word: currency, Kind: TokenCurrency, Start: 0, End: max(end, 1)})
+	}
+	return tokens
+}
+
+// appendScaleTokens appends the scale words after the group at scaleIndex,
+// with the "tỷ" that BillionsAfter puts after it. lower holds the groups
+// below it, highest first.
+func (vc *vietnameseConverter) appendScaleTokens(tokens []Token, scaleIndex int, lower []int, start, end int) []Token {
+	if scaleIndex%3 != 0 {
+		tokens = append(tokens, Token{Word: vc.scales[scaleIndex%3], Kind: TokenScale, Start: start, End: end})
+	}
+	for k := BillionsAfter(scaleIndex, func(k int) int { return lower[scaleIndex-1-k] }); k > 0; k-- {
+		tokens = append(tokens, Token{Word: vc.scales[3], Kind: TokenScale, Start: start, End: end})
 	}
+	return tokens
+}
+
+// ConvertSigned converts a possibly negative number, writing the sign as configured by opts
+func (vc *vietnameseConverter) ConvertSigned(number int64, currency string, opts SignOptions) (string, error) {
+	return vc.opts.encodeResult(convertSigned(vc, number, currency, opts))
+}
+
+// ConvertOrdinal reads number as an ordinal: "thứ nhất", "thứ hai", "thứ tư", ...
+func (vc *vietnameseConverter) ConvertOrdinal(number int64) (string, error) {
+	return vc.opts.encodeResult(convertOrdinal(vc.formal, number, vc.opts))
+}
+
+// ConvertSinoVietnamese reads number with Hán-Việt numerals: "nhất vạn nhị thiên"
+func (vc *vietnameseConverter) ConvertSinoVietnamese(number int64) (string, error) {
+	return vc.opts.encodeResult(convertSinoVietnamese(number))
+}
+
+// ConvertDate reads a calendar date: "ngày mười lăm tháng tư năm hai nghìn không trăm hai mươi tư"
+func (vc *vietnameseConverter) ConvertDate(date time.Time, opts DateOptions) (string, error) {
+	return vc.opts.encodeResult(convertDate(vc.formal, date, opts))
+}
+
+// ConvertTime reads a time of day: "tám giờ năm phút"
+func (vc *vietnameseConverter) ConvertTime(hour, minute, second int) (string, error) {
+	return vc.opts.encodeResult(convertTime(vc.formal, hour, minute, second))
+}
+
+// ConvertDigitSequence reads an identifier digit by digit: "không chín một hai, ba bốn năm"
+func (vc *vietnameseConverter) ConvertDigitSequence(digits string, opts DigitOptions) (string, error) {
+	return vc.opts.encodeResult(convertDigitSequence(vc.lex, digits, opts))
+}
+
+// ConvertPercent reads a percentage: "mười hai phẩy năm phần trăm"
+func (vc *vietnameseConverter) ConvertPercent(amount Decimal) (string, error) {
+	return vc.opts.encodeResult(convertPercent(vc, amount))
+}
+
+// ConvertFraction reads a fraction: "ba phần tư"
+func (vc *vietnameseConverter) ConvertFraction(numerator, denominator int64) (string, error) {
+	return vc.opts.encodeResult(convertFraction(vc.formal, numerator, denominator))
+}
+
+// ConvertMeasure reads an amount in a registered unit: "hai mươi lăm ki-lô-mét vuông"
+func (vc *vietnameseConverter) ConvertMeasure(amount Decimal, unit string) (string, error) {
+	return vc.opts.encodeResult(convertMeasure(vc, amount, unit))
+}
 
-	return result, nil
+// ConvertDecimal converts an exact decimal amount, reading the fraction as configured by opts
+func (vc *vietnameseConverter) ConvertDecimal(amount Decimal, currency string, opts DecimalOptions) (string, error) {
+	return vc.opts.encodeResult(convertDecimal(vc, amount, currency, opts))
 }
 
-func (vc *vietnameseConverter) splitIntoGroups(number int64) []int {
+func (vc *vietnameseConverter) splitIntoGroups(number uint64) []int {
 	var groups []int
 	
 	for number > 0 {
@@ -123,88 +258,56 @@ func (vc *vietnameseConverter) splitIntoGroups(number int64) []int {
 	return groups
 }
 
-func (vc *vietnameseConverter) convertThreeDigitGroup(group int, scaleIndex int, isFirst bool) string {
-	if group == 0 {
-		return ""
-	}
-
+// appendGroupTokens appends the reading of one non-zero three-digit group
+// whose units digit sits just before end
+func (vc *vietnameseConverter) appendGroupTokens(tokens []Token, group int, isFirst bool, end int) []Token {
 	hundreds := group / 100
 	remainder := group % 100
 	tens := remainder / 10
 	units := remainder % 10
 
-	var parts []string
+	hundredsAt, tensAt, unitsAt := end-3, end-2, end-1
+	token := func(word string, kind TokenKind, at int) Token {
+		return Token{Word: word, Kind: kind, Start: at, End: at + 1}
+	}
 
 	// Hundreds
 	if hundreds > 0 {
-		parts = append(parts, vc.units[hundreds]+" trăm")
-	} else if !isFirst && (tens > 0 || units > 0) {
-		parts = append(parts, "không trăm")
+		tokens = append(tokens, token(vc.units[hundreds]+" "+vc.lex.Hundred, TokenHundred, hundredsAt))
+	} else if !isFirst && (tens > 0 || units > 0) && !vc.opts.zeros.OmitZeroHundreds {
+		tokens = append(tokens, token(vc.zeroWords[2], TokenConnector, hundredsAt))
 	}
 
 	// Tens/Units
 	if tens > 1 {
-		parts = append(parts, vc.units[tens]+" mươi")
+		if units > 0 && vc.opts.style == StyleColloquial {
+			// "hai mốt"
+			tokens = append(tokens, token(vc.units[tens], TokenTen, tensAt))
+		} else {
+			tokens = append(tokens, token(vc.tens[tens], TokenTen, tensAt))
+		}
 		if units == 1 {
-			parts = append(parts, "mốt")
+			tokens = append(tokens, token(vc.lex.OneAfterTens, TokenUnit, unitsAt))
 		} else if units == 4 {
-			parts = append(parts, "tư")
+			tokens = append(tokens, token(vc.lex.FourAfterTens, TokenUnit, unitsAt))
 		} else if units == 5 {
-			parts = append(parts, "lăm")
+			tokens = append(tokens, token(vc.lex.FiveAfterTens, TokenUnit, unitsAt))
 		} else if units > 0 {
-			parts = append(parts, vc.units[units])
+			tokens = append(tokens, token(vc.units[units], TokenUnit, unitsAt))
 		}
 	} else if tens == 1 {
-		parts = append(parts, "mười")
+		tokens = append(tokens, token(vc.tens[1], TokenTen, tensAt))
 		if units == 5 {
-			parts = append(parts, "lăm")
+			tokens = append(tokens, token(vc.lex.FiveAfterTen, TokenUnit, unitsAt))
 		} else if units > 0 {
-			parts = append(parts, vc.units[units])
+			tokens = append(tokens, token(vc.units[units], TokenUnit, unitsAt))
 		}
 	} else if tens == 0 && units > 0 {
-		if hundreds > 0 {
-			parts = append(parts, "lẻ")
+		if hundreds > 0 || (!isFirst && vc.opts.zeros.OddZeroAlways) {
+			tokens = append(tokens, token(vc.zeroWords[1], TokenConnector, tensAt))
 		}
-		parts = append(parts, vc.units[units])
+		tokens = append(tokens, token(vc.units[units], TokenUnit, unitsAt))
 	}
 
-	return strings.Join(parts, " ")
-}
-
-// Removed: now handled in convertThreeDigitGroup
-func (vc *vietnameseConverter) convertTensAndUnits(tens, units, scaleIndex int, hasHundreds bool) string {
-	return ""
-}
-
-func (vc *vietnameseConverter) getUnitWord(digit int, isStandalone bool, scaleIndex int) string {
-	if digit == 0 {
-		return ""
-	}
-	
-	if digit == 4 {
-		if isStandalone || scaleIndex > 0 {
-			return "bốn"
-		}
-		return "tư"
-	}
-	
-	return vc.units[digit]
-}
-
-func (vc *vietnameseConverter) normalizeVietnamese(text string) string {
-	words := strings.Fields(text)
-	
-	var normalized []string
-	for i, word := range words {
-		if word == "một" && i > 0 && i < len(words)-1 {
-			prevWord := words[i-1]
-			if strings.HasSuffix(prevWord, "mười") && prevWord != "mười" {
-				normalized = append(normalized, "mốt")
-				continue
-			}
-		}
-		normalized = append(normalized, word)
-	}
-	
-	return strings.Join(normalized, " ")
+	return tokens
 }
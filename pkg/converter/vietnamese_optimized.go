@@ -1,9 +1,11 @@
 package converter
 
 import (
-	"fmt"
+	"io"
+	"math/big"
 	"strings"
 	"sync"
+	"time"
 )
 
 // TurboVietnameseConverter provides the fastest possible number-to-text conversion
@@ -12,47 +14,117 @@ type TurboVietnameseConverter struct {
 	// Static maps for faster lookups and reduced allocations
 	units      [10]string
 	tens       [10]string
-	scales     [7]string
+	scales     [4]string
 	specialMap map[int]string
+	lex        Lexicon
+	opts       options
+	formal     *TurboVietnameseConverter // itself unless the style is colloquial
 	
-	// Pre-allocated buffer pool to avoid repeated allocations in high-performance scenarios
-	bufferPool *sync.Pool
+	// Pre-computed readings of every three-digit group, shared by converters with the same lexicon
+	table *groupTable
 }
 
+// groupTable holds the reading of each three-digit group, so conversion is a
+// handful of appends with no per-digit branching
+type groupTable struct {
+	first [1000]string // the highest group: 5 -> "năm"
+	inner [1000]string // any later group: 5 -> "không trăm năm"
+}
+
+// groupTables caches one table per lexicon, style and zero policy, since WithOptions runs per request
+var groupTables sync.Map // groupTableKey -> *groupTable
+
+type groupTableKey struct {
+	lex   Lexicon
+	style Style
+	zeros ZeroPolicy
+}
+
+// maxUint64Text bounds the reading of any uint64 without currency,
+// so the fast path can work in a stack buffer
+const maxUint64Text = 512
+
 // NewTurboConverter creates a new instance of the ultra-optimized Vietnamese converter
-func NewTurboConverter() NumberConverter {
+func NewTurboConverter(opts ...Option) NumberConverter {
+	return newTurboConverter(newOptions(opts))
+}
+
+func newTurboConverter(o options) *TurboVietnameseConverter {
+	lex := o.buildLexicon()
+	
 	conv := &TurboVietnameseConverter{
-		// Using arrays instead of slices to avoid heap allocations
-		units: [10]string{
-			"", "một", "hai", "ba", "bốn", "năm", "sáu", "bảy", "tám", "chín",
-		},
-		tens: [10]string{
-			"", "mười", "hai mươi", "ba mươi", "bốn mươi", "năm mươi",
-			"sáu mươi", "bảy mươi", "tám mươi", "chín mươi",
-		},
-		scales: [7]string{
-			"", "nghìn", "triệu", "tỷ", "nghìn tỷ", "triệu tỷ", "tỷ tỷ",
+		// Larger scales chain on "tỷ": nghìn tỷ, triệu tỷ, tỷ tỷ, ...
+		scales: [4]string{
+			"", lex.Thousand, lex.Million, lex.Billion,
 		},
 		// Pre-compute special cases for faster access
 		specialMap: map[int]string{
-			1: "mốt",   // Special case for "một" in tens position
-			4: "tư",    // Special case for "bốn" in tens position
-			5: "lăm",   // Special case for "năm" in tens position
-		},
-		// Create string builder pool for reuse
-		bufferPool: &sync.Pool{
-			New: func() interface{} {
-				// Pre-allocate builders with sufficient capacity
-				sb := &strings.Builder{}
-				sb.Grow(100)
-				return sb
-			},
+			1: lex.OneAfterTens,  // Special case for "một" in tens position
+			4: lex.FourAfterTens, // Special case for "bốn" in tens position
+			5: lex.FiveAfterTens, // Special case for "năm" in tens position
 		},
+		lex:  lex,
+		opts: o,
+	}
+	
+	// Using arrays instead of slices to avoid heap allocations
+	conv.tens[1] = lex.Ten
+	for d := 1; d <= 9; d++ {
+		conv.units[d] = lex.Digits[d]
+		if d >= 2 {
+			conv.tens[d] = lex.Digits[d] + " " + lex.Tens
+		}
+	}
+	
+	key := groupTableKey{lex: lex, style: o.style, zeros: o.zeros}
+	if cached, ok := groupTables.Load(key); ok {
+		conv.table = cached.(*groupTable)
+	} else {
+		table := conv.buildGroupTable()
+		cached, _ := groupTables.LoadOrStore(key, table)
+		conv.table = cached.(*groupTable)
+	}
+	
+	conv.formal = conv
+	if o.style != StyleFormal {
+		conv.formal = newTurboConverter(o.formal())
 	}
 	
 	return conv
 }
 
+// buildGroupTable reads every three-digit group once with appendGroup
+func (c *TurboVietnameseConverter) buildGroupTable() *groupTable {
+	table := &groupTable{}
+	var sb strings.Builder
+	for group := 1; group < 1000; group++ {
+		sb.Reset()
+		c.appendGroup(&sb, group, 0, true)
+		table.first[group] = sb.String()
+		
+		sb.Reset()
+		c.appendGroup(&sb, group, 0, false)
+		table.inner[group] = sb.String()
+	}
+	return table
+}
+
+// GroupReadings returns the reading of every three-digit group under opts, as
+// the turbo converter reads them: first[g] when g is the highest group of a
+// number and inner[g] for any later group. Index 0 is empty, since a zero
+// group is silent or read by ZeroPolicy.ReadsEmptyGroup. The words are not
+// encoded; the style is ignored, only formal readings are tabled.
+func GroupReadings(opts ...Option) (first, inner [1000]string) {
+	o := newOptions(opts).formal()
+	table := newTurboConverter(o).table
+	return table.first, table.inner
+}
+
+// WithOptions returns a copy of the converter with opts applied on top of its own
+func (c *TurboVietnameseConverter) WithOptions(opts ...Option) NumberConverter {
+	return newTurboConverter(c.opts.with(opts))
+}
+
 // Convert converts a number to Vietnamese text
 func (c *TurboVietnameseConverter) Convert(number int64) (string, error) {
 	return c.ConvertWithCurrency(number, "đồng")
@@ -62,65 +134,138 @@ func (c *TurboVietnameseConverter) Convert(number int64) (string, error) {
 func (c *TurboVietnameseConverter) ConvertWithCurrency(number int64, currency string) (string, error) {
 	// Handle validation with pre-checks
 	if number < 0 {
-		return "", fmt.Errorf("negative numbers not supported")
-	}
-	if number > 999999999999999 {
-		return "", fmt.Errorf("number too large (max: 999,999,999,999,999)")
+		return "", ErrNegative
 	}
-	if number == 0 {
-		if currency != "" {
-			return "không " + currency, nil
-		}
-		return "không", nil
+	return c.ConvertUint64(uint64(number), currency)
+}
+
+// ConvertUint64 converts the full unsigned 64-bit range on the fast path
+func (c *TurboVietnameseConverter) ConvertUint64(number uint64, currency string) (string, error) {
+	// The buffer stays on the stack; the only allocation is the returned string
+	var buf [maxUint64Text]byte
+	return string(c.AppendUint64(buf[:0], number, currency)), nil
+}
+
+// AppendConvert appends the reading of number to dst and returns the extended
+// buffer. With enough capacity in dst it does not allocate.
+func (c *TurboVietnameseConverter) AppendConvert(dst []byte, number int64, currency string) ([]byte, error) {
+	if number < 0 {
+		return dst, ErrNegative
 	}
+	return c.AppendUint64(dst, uint64(number), currency), nil
+}
 
-	// Get a pre-allocated string builder from the pool
-	sb := c.bufferPool.Get().(*strings.Builder)
-	sb.Reset() // Clear any previous content
-	defer func() {
-		// Return to pool when done
-		c.bufferPool.Put(sb)
-	}()
-	
+// AppendUint64 appends the reading of number to dst and returns the extended buffer
+func (c *TurboVietnameseConverter) AppendUint64(dst []byte, number uint64, currency string) []byte {
 	// Direct, stack-based processing of digits
-	// This approach avoids both array creation and sorting
-	// Using 5 as that's the max needed for 15 digits
-	var groups [5]int
+	// Using 7 as that's the max needed for 20 digits
+	var groups [7]int
 	var groupCount int
 	
 	// Extract groups of 3 digits with direct arithmetic
-	temp := number
-	for temp > 0 {
+	for temp := number; temp > 0; temp /= 1000 {
 		groups[groupCount] = int(temp % 1000)
-		temp /= 1000
 		groupCount++
 	}
 	
+	start := len(dst)
+	dst = c.appendGroups(dst, groups[:groupCount], currency)
+	return c.opts.encodeTail(dst, start)
+}
+
+// WriteConvert writes the reading of number to w through a pooled buffer
+func (c *TurboVietnameseConverter) WriteConvert(w io.Writer, number int64, currency string) (int, error) {
+	return writeConverted(c, w, number, currency)
+}
+
+// ConvertBig converts an arbitrarily large non-negative integer
+func (c *TurboVietnameseConverter) ConvertBig(number *big.Int, currency string) (string, error) {
+	if number == nil || number.Sign() < 0 {
+		return "", ErrNegative
+	}
+	if number.IsUint64() {
+		return c.ConvertUint64(number.Uint64(), currency)
+	}
+	return c.ConvertDigits(number.String(), currency)
+}
+
+// ConvertDigits converts a non-negative integer given as a string of decimal digits
+func (c *TurboVietnameseConverter) ConvertDigits(digits string, currency string) (string, error) {
+	highFirst, err := splitDigitGroups(digits)
+	if err != nil {
+		return "", err
+	}
+	
+	// appendGroups indexes groups by scale, lowest first
+	groups := make([]int, len(highFirst))
+	for i, group := range highFirst {
+		groups[len(groups)-1-i] = group
+	}
+	return string(c.opts.encodeTail(c.appendGroups(nil, groups, currency), 0)), nil
+}
+
+// appendGroups reads three-digit groups indexed by scale, groups[0] being the units.
+// No groups at all reads zero.
+func (c *TurboVietnameseConverter) appendGroups(dst []byte, groups []int, currency string) []byte {
+	groupCount := len(groups)
+	if groupCount == 0 {
+		dst = append(dst, c.lex.Digits[0]...)
+	}
+	
+	tailAt, tail, digit := -1, tailNone, 0
+	if c.opts.style == StyleColloquial {
+		tailAt, tail, digit = colloquialTail(groupCount, func(k int) int { return groups[k] })
+	}
+	
 	// Process each group from highest to lowest without recursion
 	firstGroup := true
 	for i := groupCount - 1; i >= 0; i-- {
 		group := groups[i]
 		
-		// Skip zero groups unless it's the only group
+		// Skip zero groups unless it's the only group or the policy reads them
 		if group == 0 {
 			if groupCount == 1 {
-				sb.WriteString("không")
+				dst = append(dst, c.lex.Digits[0]...)
+			} else if c.opts.zeros.ReadsEmptyGroup(i, func(k int) int { return groups[k] }) {
+				dst = append(dst, ' ')
+				dst = append(dst, c.lex.Digits[0]...)
+				dst = append(dst, ' ')
+				dst = append(dst, c.scales[i%3]...)
 			}
 			continue
 		}
 		
-		// Add space between groups except for the first one
-		if !firstGroup {
-			sb.WriteRune(' ')
+		switch {
+		case i == tailAt && tail == tailAfterScale:
+			// The scale before carries the place: "một triệu hai"
+			dst = append(dst, ' ')
+			dst = append(dst, c.lex.shortWord(digit)...)
+			continue
+		case i == tailAt && tail == tailAfterHundred:
+			if !firstGroup {
+				dst = append(dst, ' ')
+			}
+			dst = append(dst, c.units[group/100]...)
+			dst = append(dst, ' ')
+			dst = append(dst, c.lex.Hundred...)
+			dst = append(dst, ' ')
+			dst = append(dst, c.lex.shortWord(digit)...)
+		case firstGroup:
+			dst = append(dst, c.table.first[group]...)
+		default:
+			dst = append(dst, ' ')
+			dst = append(dst, c.table.inner[group]...)
 		}
 		
-		// Convert three-digit group with direct string concat
-		c.appendGroup(sb, group, i, firstGroup)
-		
 		// Add appropriate scale suffix
-		if i > 0 {
-			sb.WriteRune(' ')
-			sb.WriteString(c.scales[i])
+		if i%3 != 0 {
+			dst = append(dst, ' ')
+			dst = append(dst, c.scales[i%3]...)
+		}
+		
+		for k := BillionsAfter(i, func(k int) int { return groups[k] }); k > 0; k-- {
+			dst = append(dst, ' ')
+			dst = append(dst, c.scales[3]...)
 		}
 		
 		firstGroup = false
@@ -128,20 +273,68 @@ func (c *TurboVietnameseConverter) ConvertWithCurrency(number int64, currency st
 	
 	// Add currency if specified
 	if currency != "" {
-		sb.WriteRune(' ')
-		sb.WriteString(currency)
-	}
-	
-	// Return the result - applying any final normalization
-	result := sb.String()
-	
-	// The only normalization needed in practice is mươi một -> mươi mốt
-	// This is more efficient than a full string replacement
-	if strings.Contains(result, "mươi một") {
-		result = strings.ReplaceAll(result, "mươi một", "mươi mốt")
+		dst = append(dst, ' ')
+		dst = append(dst, currency...)
 	}
 	
-	return result, nil
+	return dst
+}
+
+// Explain converts a non-negative integer given as decimal digits and
+// reports which digits produced each word. The table-driven path keeps no
+// positions, so the reading is traced by the reference engine with the same options.
+func (c *TurboVietnameseConverter) Explain(digits string, currency string) (Explanation, error) {
+	return newVietnameseConverter(c.opts).Explain(digits, currency)
+}
+
+// ConvertSigned converts a possibly negative number, writing the sign as configured by opts
+func (c *TurboVietnameseConverter) ConvertSigned(number int64, currency string, opts SignOptions) (string, error) {
+	return c.opts.encodeResult(convertSigned(c, number, currency, opts))
+}
+
+// ConvertOrdinal reads number as an ordinal: "thứ nhất", "thứ hai", "thứ tư", ...
+func (c *TurboVietnameseConverter) ConvertOrdinal(number int64) (string, error) {
+	return c.opts.encodeResult(convertOrdinal(c.formal, number, c.opts))
+}
+
+// ConvertSinoVietnamese reads number with Hán-Việt numerals: "nhất vạn nhị thiên"
+func (c *TurboVietnameseConverter) ConvertSinoVietnamese(number int64) (string, error) {
+	return c.opts.encodeResult(convertSinoVietnamese(number))
+}
+
+// ConvertDate reads a calendar date: "ngày mười lăm tháng tư năm hai nghìn không trăm hai mươi tư"
+func (c *TurboVietnameseConverter) ConvertDate(date time.Time, opts DateOptions) (string, error) {
+	return c.opts.encodeResult(convertDate(c.formal, date, opts))
+}
+
+// ConvertTime reads a time of day: "tám giờ năm phút"
+func (c *TurboVietnameseConverter) ConvertTime(hour, minute, second int) (string, error) {
+	return c.opts.encodeResult(convertTime(c.formal, hour, minute, second))
+}
+
+// ConvertDigitSequence reads an identifier digit by digit: "không chín một hai, ba bốn năm"
+func (c *TurboVietnameseConverter) ConvertDigitSequence(digits string, opts DigitOptions) (string, error) {
+	return c.opts.encodeResult(convertDigitSequence(c.lex, digits, opts))
+}
+
+// ConvertPercent reads a percentage: "mười hai phẩy năm phần trăm"
+func (c *TurboVietnameseConverter) ConvertPercent(amount Decimal) (string, error) {
+	return c.opts.encodeResult(convertPercent(c, amount))
+}
+
+// ConvertFraction reads a fraction: "ba phần tư"
+func (c *TurboVietnameseConverter) ConvertFraction(numerator, denominator int64) (string, error) {
+	return c.opts.encodeResult(convertFraction(c.formal, numerator, denominator))
+}
+
+// ConvertMeasure reads an amount in a registered unit: "hai mươi lăm ki-lô-mét vuông"
+func (c *TurboVietnameseConverter) ConvertMeasure(amount Decimal, unit string) (string, error) {
+	return c.opts.encodeResult(convertMeasure(c, amount, unit))
+}
+
+// ConvertDecimal converts an exact decimal amount, reading the fraction as configured by opts
+func (c *TurboVietnameseConverter) ConvertDecimal(amount Decimal, currency string, opts DecimalOptions) (string, error) {
+	return c.opts.encodeResult(convertDecimal(c, amount, currency, opts))
 }
 
 // appendGroup directly appends a 3-digit group conversion to the string builder
@@ -155,14 +348,15 @@ func (c *TurboVietnameseConverter) appendGroup(sb *strings.Builder, group int, s
 	// Process hundreds place
 	if hundreds > 0 {
 		sb.WriteString(c.units[hundreds])
-		sb.WriteString(" trăm")
+		sb.WriteRune(' ')
+		sb.WriteString(c.lex.Hundred)
 		
 		// Only add connective words if needed
 		if remainder > 0 {
 			sb.WriteRune(' ')
 			if tens == 0 {
 				// Special case for numbers like x01 to x09
-				sb.WriteString("lẻ")
+				sb.WriteString(c.lex.OddZero)
 				sb.WriteRune(' ')
 				sb.WriteString(c.units[units])
 				return
@@ -172,22 +366,28 @@ func (c *TurboVietnameseConverter) appendGroup(sb *strings.Builder, group int, s
 			return
 		}
 	} else if !isFirst && remainder > 0 {
-		// Handle cases like x,001 where x > 0
-		sb.WriteString("không trăm")
-		sb.WriteRune(' ')
-		if tens == 0 {
-			sb.WriteString("lẻ")
+		// Handle cases like x,001 where x > 0: "không trăm một", without "lẻ"
+		// unless the zero policy says otherwise
+		if !c.opts.zeros.OmitZeroHundreds {
+			sb.WriteString(c.lex.Digits[0])
+			sb.WriteRune(' ')
+			sb.WriteString(c.lex.Hundred)
+			sb.WriteRune(' ')
+		}
+		if tens == 0 && c.opts.zeros.OddZeroAlways {
+			sb.WriteString(c.lex.OddZero)
 			sb.WriteRune(' ')
-			sb.WriteString(c.units[units])
-			return
 		}
 	}
 	
 	// Process tens place with special cases
 	if tens > 1 {
-		// 20-99
-		sb.WriteString(c.units[tens])
-		sb.WriteString(" mươi")
+		// 20-99; colloquially "hai mốt"
+		if units > 0 && c.opts.style == StyleColloquial {
+			sb.WriteString(c.units[tens])
+		} else {
+			sb.WriteString(c.tens[tens])
+		}
 		if units > 0 {
 			sb.WriteRune(' ')
 			// Special cases handled via map for better performance
@@ -199,11 +399,11 @@ func (c *TurboVietnameseConverter) appendGroup(sb *strings.Builder, group int, s
 		}
 	} else if tens == 1 {
 		// 10-19
-		sb.WriteString("mười")
+		sb.WriteString(c.tens[1])
 		if units > 0 {
 			sb.WriteRune(' ')
 			if units == 5 {
-				sb.WriteString("lăm")
+				sb.WriteString(c.lex.FiveAfterTen)
 			} else {
 				sb.WriteString(c.units[units])
 			}
@@ -89,6 +89,37 @@ func TestVietnameseConverter_FullDataset(t *testing.T) {
 	}
 }
 
+// TestInnerGroupGolden pins the reading of zero hundreds in groups after the
+// first, where "lẻ" is only read after a non-zero hundreds digit
+func TestInnerGroupGolden(t *testing.T) {
+	tests := []struct {
+		number int64
+		want   string
+	}{
+		{101, "một trăm lẻ một đồng"},
+		{1001, "một nghìn không trăm một đồng"},
+		{1005, "một nghìn không trăm năm đồng"},
+		{1015, "một nghìn không trăm mười lăm đồng"},
+		{1101, "một nghìn một trăm lẻ một đồng"},
+		{1_005_000, "một triệu không trăm năm nghìn đồng"},
+		{1_000_005, "một triệu không trăm năm đồng"},
+		{5_000_000_007, "năm tỷ không trăm bảy đồng"},
+	}
+
+	engines := map[string]converter.NumberConverter{
+		"vietnamese": converter.NewVietnameseConverter(),
+		"turbo":      converter.NewTurboConverter(),
+	}
+	for name, conv := range engines {
+		for _, tt := range tests {
+			got, err := conv.Convert(tt.number)
+			if err != nil || got != tt.want {
+				t.Errorf("%s: Convert(%d) = %q, %v, want %q", name, tt.number, got, err, tt.want)
+			}
+		}
+	}
+}
+
 // Benchmark function for performance testing
 func BenchmarkVietnameseConverter_Convert(b *testing.B) {
 	conv := converter.NewConverter()
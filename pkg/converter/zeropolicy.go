@@ -0,0 +1,41 @@
+package converter
+
+// ZeroPolicy selects how zeros inside a number are read, which differs between
+// house styles. The zero value is the default reading:
+// 1.005.000 is "một triệu không trăm năm nghìn" and 1.000.005 "một triệu không trăm năm".
+// The first group of a number never reads its leading zeros.
+type ZeroPolicy struct {
+	// OmitZeroHundreds drops "không trăm" from groups after the first:
+	// 1.020 is "một nghìn hai mươi" instead of "một nghìn không trăm hai mươi"
+	OmitZeroHundreds bool
+	// OddZeroAlways puts "lẻ" before a lone units digit in every group after the
+	// first, not only after a non-zero hundreds digit: 1.005 is
+	// "một nghìn không trăm lẻ năm", or "một nghìn lẻ năm" with OmitZeroHundreds
+	OddZeroAlways bool
+	// ReadEmptyGroups reads a zero "nghìn" or "triệu" group followed by a non-zero
+	// group of the same nine-digit block: 1.000.005 is
+	// "một triệu không nghìn không trăm năm"
+	ReadEmptyGroups bool
+}
+
+// WithZeroPolicy selects how zeros inside a number are read
+func WithZeroPolicy(p ZeroPolicy) Option {
+	return func(o *options) {
+		o.zeros = p
+	}
+}
+
+// ReadsEmptyGroup reports whether the zero group at scaleIndex is read under p.
+// lower(k) returns the group at scale k, for k below scaleIndex.
+func (p ZeroPolicy) ReadsEmptyGroup(scaleIndex int, lower func(k int) int) bool {
+	if !p.ReadEmptyGroups || scaleIndex%3 == 0 {
+		return false
+	}
+	// Only the groups up to the next "tỷ" count; past it the "tỷ" carries the place
+	for k := scaleIndex - scaleIndex%3; k < scaleIndex; k++ {
+		if lower(k) != 0 {
+			return true
+		}
+	}
+	return false
+}
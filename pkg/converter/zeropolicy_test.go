@@ -0,0 +1,76 @@
+package converter_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+func TestZeroPolicy(t *testing.T) {
+	omit := converter.ZeroPolicy{OmitZeroHundreds: true}
+	odd := converter.ZeroPolicy{OddZeroAlways: true}
+	omitOdd := converter.ZeroPolicy{OmitZeroHundreds: true, OddZeroAlways: true}
+	empty := converter.ZeroPolicy{ReadEmptyGroups: true}
+
+	tests := []struct {
+		policy converter.ZeroPolicy
+		n      int64
+		want   string
+	}{
+		// The default policy keeps the historical reading
+		{converter.ZeroPolicy{}, 1_005, "một nghìn không trăm năm"},
+		{converter.ZeroPolicy{}, 1_005_000, "một triệu không trăm năm nghìn"},
+		{converter.ZeroPolicy{}, 1_000_005, "một triệu không trăm năm"},
+		{converter.ZeroPolicy{}, 105, "một trăm lẻ năm"},
+
+		{omit, 1_005, "một nghìn năm"},
+		{omit, 1_020, "một nghìn hai mươi"},
+		{omit, 1_105, "một nghìn một trăm lẻ năm"},
+		{omit, 5_000, "năm nghìn"},
+
+		{odd, 1_005, "một nghìn không trăm lẻ năm"},
+		{odd, 1_005_000, "một triệu không trăm lẻ năm nghìn"},
+		{odd, 1_015, "một nghìn không trăm mười lăm"},
+		{odd, 5, "năm"},
+		{odd, 5_001, "năm nghìn không trăm lẻ một"},
+
+		{omitOdd, 1_005, "một nghìn lẻ năm"},
+		{omitOdd, 1_025, "một nghìn hai mươi lăm"},
+
+		{empty, 1_000_005, "một triệu không nghìn không trăm năm"},
+		{empty, 1_000_000, "một triệu"},
+		{empty, 1_005_000, "một triệu không trăm năm nghìn"},
+		{empty, 1_000_000_005, "một tỷ không triệu không nghìn không trăm năm"},
+		{empty, 1_000_005_000, "một tỷ không triệu không trăm năm nghìn"},
+		// Past the "tỷ" the chained scale carries the place
+		{empty, 1_000_000_000_000_005, "một triệu tỷ không triệu không nghìn không trăm năm"},
+		{empty, 1_000_000_000_000, "một nghìn tỷ"},
+	}
+
+	for name, newConv := range map[string]func(...converter.Option) converter.NumberConverter{
+		"vietnamese": converter.NewVietnameseConverter,
+		"turbo":      converter.NewTurboConverter,
+	} {
+		for _, tt := range tests {
+			conv := newConv(converter.WithZeroPolicy(tt.policy))
+			got, err := conv.ConvertWithCurrency(tt.n, "")
+			if err != nil || got != tt.want {
+				t.Errorf("%s: %+v ConvertWithCurrency(%d) = %q, %v, want %q", name, tt.policy, tt.n, got, err, tt.want)
+			}
+			// Every policy's reading parses back to the number
+			if amount, err := converter.ParseAmount(got, converter.WithZeroPolicy(tt.policy)); err != nil || !amount.Value.IsInt64() || amount.Value.Int64() != tt.n {
+				t.Errorf("%s: %+v ParseAmount(%q) = %v, %v, want %d", name, tt.policy, got, amount.Value, err, tt.n)
+			}
+		}
+	}
+}
+
+func TestZeroPolicyDialect(t *testing.T) {
+	conv := converter.NewTurboConverter(
+		converter.WithDialect(converter.DialectSouthern),
+		converter.WithZeroPolicy(converter.ZeroPolicy{OddZeroAlways: true}),
+	)
+	if got, _ := conv.ConvertWithCurrency(1_005_000, ""); got != "một triệu không trăm linh năm ngàn" {
+		t.Errorf("ConvertWithCurrency(1005000) = %q", got)
+	}
+}
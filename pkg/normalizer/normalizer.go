@@ -0,0 +1,388 @@
+// Package normalizer rewrites free Vietnamese text for text-to-speech, spelling
+// out the numbers, money, percentages, dates and times it contains.
+package normalizer
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"vietnamese-converter/pkg/converter"
+)
+
+// Kind classifies a replaced span
+type Kind string
+
+const (
+	KindNumber   Kind = "number"
+	KindMoney    Kind = "money"
+	KindPercent  Kind = "percent"
+	KindMeasure  Kind = "measure"
+	KindFraction Kind = "fraction"
+	KindDate     Kind = "date"
+	KindTime     Kind = "time"
+	KindDigits   Kind = "digits" // phone and ID numbers, read digit by digit
+)
+
+// Converter is what the normalizer needs from a converter; both built-in
+// converters implement it
+type Converter interface {
+	converter.NumberConverter
+	converter.DecimalConverter
+	converter.DateTimeConverter
+	converter.QuantityConverter
+	converter.DigitSequenceConverter
+}
+
+// Span records one replacement, with byte offsets into both texts
+type Span struct {
+	Kind        Kind   `json:"kind"`
+	Start       int    `json:"start"` // in the original text
+	End         int    `json:"end"`
+	OutStart    int    `json:"normalized_start"` // in the normalized text
+	OutEnd      int    `json:"normalized_end"`
+	Original    string `json:"original"`
+	Replacement string `json:"replacement"`
+}
+
+// Result is the normalized text and the spans that were replaced, in order
+type Result struct {
+	Text  string
+	Spans []Span
+}
+
+// OriginalOffset maps a byte offset in the normalized text back to the original
+// text. Offsets inside a replacement map to the start of the replaced span.
+func (r Result) OriginalOffset(offset int) int {
+	delta := 0
+	for _, s := range r.Spans {
+		if offset < s.OutStart {
+			break
+		}
+		if offset < s.OutEnd {
+			return s.Start
+		}
+		delta = s.End - s.OutEnd
+	}
+	return offset + delta
+}
+
+// Normalizer spells out the numbers in Vietnamese text
+type Normalizer struct {
+	conv Converter
+}
+
+// New creates a normalizer that reads numbers with conv
+func New(conv Converter) *Normalizer {
+	return &Normalizer{conv: conv}
+}
+
+var (
+	// 15/04/2024, 02/09 and 15-04-2024
+	dateRe = regexp.MustCompile(`^(\d{1,2})/(\d{1,2})(?:/(\d{4}))?|^(\d{1,2})-(\d{1,2})-(\d{4})`)
+	// 08:05 and 08:05:30
+	timeRe = regexp.MustCompile(`^(\d{1,2}):(\d{2})(?::(\d{2}))?`)
+	// 1.250.000,5 with dot thousands, or a plain 1250, 12,5 or 3.5
+	numberRe = regexp.MustCompile(`^-?\d{1,3}(?:\.\d{3})+(?:,\d+)?|^-?\d+(?:[.,]\d+)?`)
+)
+
+// currencySuffixes are the ways "đồng" is abbreviated after an amount
+var currencySuffixes = []string{"VNĐ", "VND", "vnđ", "vnd", "₫", "đ"}
+
+// Normalize replaces every number it recognises and leaves the rest of the text untouched.
+// Numbers glued to letters, such as "A4" or "3G", are left as they are.
+func (n *Normalizer) Normalize(text string) Result {
+	var out strings.Builder
+	var spans []Span
+	last := 0
+
+	for i := 0; i < len(text); {
+		c := text[i]
+		if !isDigit(c) && !(c == '-' && i+1 < len(text) && isDigit(text[i+1])) {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			i += size
+			continue
+		}
+
+		if prev, _ := utf8.DecodeLastRuneInString(text[:i]); i > 0 && isWordRune(prev) {
+			// "10-20" is a range, not a negative 20
+			if c == '-' {
+				i++
+			} else {
+				i = skipWord(text, i)
+			}
+			continue
+		}
+
+		end, kind, replacement, ok := n.match(text, i)
+		if !ok {
+			i = skipWord(text, i)
+			continue
+		}
+
+		out.WriteString(text[last:i])
+		outStart := out.Len()
+		out.WriteString(replacement)
+		spans = append(spans, Span{
+			Kind:        kind,
+			Start:       i,
+			End:         end,
+			OutStart:    outStart,
+			OutEnd:      out.Len(),
+			Original:    text[i:end],
+			Replacement: replacement,
+		})
+		last = end
+		i = end
+	}
+	out.WriteString(text[last:])
+
+	return Result{Text: out.String(), Spans: spans}
+}
+
+// match reads the number starting at start, returning where it ends and its reading
+func (n *Normalizer) match(text string, start int) (int, Kind, string, bool) {
+	rest := text[start:]
+
+	if m := dateRe.FindStringSubmatch(rest); m != nil && atBoundary(text, start+len(m[0])) {
+		if end, reading, ok := n.readDate(text, start, m); ok {
+			return end, KindDate, reading, true
+		}
+		if m[3] != "" || m[6] != "" {
+			return 0, "", "", false // an impossible date such as 31/02/2024
+		}
+	}
+
+	if m := timeRe.FindStringSubmatch(rest); m != nil && atBoundary(text, start+len(m[0])) {
+		hour, _ := strconv.Atoi(m[1])
+		minute, _ := strconv.Atoi(m[2])
+		second := 0
+		if m[3] != "" {
+			second, _ = strconv.Atoi(m[3])
+		}
+		if reading, err := n.conv.ConvertTime(hour, minute, second); err == nil {
+			return start + len(m[0]), KindTime, reading, true
+		}
+		return 0, "", "", false
+	}
+
+	m := numberRe.FindString(rest)
+	if m == "" {
+		return 0, "", "", false
+	}
+	end := start + len(m)
+
+	// Leading zeros mark an identifier such as a phone number
+	if len(m) > 1 && m[0] == '0' && isAllDigits(m) && atBoundary(text, end) {
+		reading, err := n.conv.ConvertDigitSequence(m, converter.DigitOptions{})
+		if err != nil {
+			return 0, "", "", false
+		}
+		return end, KindDigits, reading, true
+	}
+
+	amount, err := converter.ParseDecimal(m)
+	if err != nil {
+		return 0, "", "", false
+	}
+
+	if suffixEnd, ok := currencySuffix(text, end); ok {
+		opts := converter.DefaultDecimalOptions()
+		opts.Sign.Style = converter.SignWord
+		reading, err := n.conv.ConvertDecimal(amount, "đồng", opts)
+		if err != nil {
+			return 0, "", "", false
+		}
+		return suffixEnd, KindMoney, reading, true
+	}
+
+	if next := skipSpace(text, end); next < len(text) && text[next] == '%' {
+		reading, err := n.conv.ConvertPercent(amount)
+		if err != nil {
+			return 0, "", "", false
+		}
+		return next + 1, KindPercent, reading, true
+	}
+
+	if unit, unitEnd, ok := unitSuffix(text, end); ok {
+		reading, err := n.conv.ConvertMeasure(amount, unit)
+		if err != nil {
+			return 0, "", "", false
+		}
+		return unitEnd, KindMeasure, reading, true
+	}
+
+	if !atBoundary(text, end) {
+		return 0, "", "", false
+	}
+
+	if num, den, ok := fraction(text, start, end); ok {
+		reading, err := n.conv.ConvertFraction(num, den)
+		if err == nil {
+			return end + fractionLen(text, end), KindFraction, reading, true
+		}
+	}
+
+	reading, err := n.conv.ConvertDecimal(amount, "", converter.DecimalOptions{
+		Fraction: converter.FractionDigits,
+		Sign:     converter.SignOptions{Style: converter.SignWord},
+	})
+	if err != nil {
+		return 0, "", "", false
+	}
+	return end, KindNumber, reading, true
+}
+
+// readDate reads a dd/mm[/yyyy] match. A day and month without a year only count
+// as a date after "ngày" or when written with a leading zero, otherwise 3/4 is a fraction.
+func (n *Normalizer) readDate(text string, start int, m []string) (int, string, bool) {
+	dayStr, monthStr, yearStr := m[1], m[2], m[3]
+	if dayStr == "" {
+		dayStr, monthStr, yearStr = m[4], m[5], m[6]
+	}
+
+	afterNgay := strings.HasSuffix(text[:start], "ngày ") || strings.HasSuffix(text[:start], "Ngày ")
+	if yearStr == "" && !afterNgay && dayStr[0] != '0' && monthStr[0] != '0' {
+		return 0, "", false
+	}
+
+	day, _ := strconv.Atoi(dayStr)
+	month, _ := strconv.Atoi(monthStr)
+	year := 2000 // a leap year, so 29/02 without a year is valid
+	if yearStr != "" {
+		year, _ = strconv.Atoi(yearStr)
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day || int(date.Month()) != month {
+		return 0, "", false
+	}
+
+	reading, err := n.conv.ConvertDate(date, converter.DateOptions{OmitYear: yearStr == ""})
+	if err != nil {
+		return 0, "", false
+	}
+	if afterNgay {
+		reading = strings.TrimPrefix(reading, "ngày ")
+	}
+	return start + len(m[0]), reading, true
+}
+
+// fraction recognises an integer immediately followed by /integer
+func fraction(text string, start, end int) (int64, int64, bool) {
+	denLen := fractionLen(text, end)
+	if denLen == 0 || !isAllDigits(strings.TrimPrefix(text[start:end], "-")) {
+		return 0, 0, false
+	}
+	num, err := strconv.ParseInt(text[start:end], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	den, err := strconv.ParseInt(text[end+1:end+denLen], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return num, den, true
+}
+
+// fractionLen returns the length of a "/digits" denominator at i, 0 if there is none
+func fractionLen(text string, i int) int {
+	if i >= len(text) || text[i] != '/' {
+		return 0
+	}
+	j := i + 1
+	for j < len(text) && isDigit(text[j]) {
+		j++
+	}
+	if j == i+1 || !atBoundary(text, j) {
+		return 0
+	}
+	return j - i
+}
+
+// currencySuffix recognises đ, VNĐ, VND or ₫ after an amount, with at most one space
+func currencySuffix(text string, i int) (int, bool) {
+	i = skipSpace(text, i)
+	for _, suffix := range currencySuffixes {
+		if strings.HasPrefix(text[i:], suffix) && atBoundary(text, i+len(suffix)) {
+			return i + len(suffix), true
+		}
+	}
+	return 0, false
+}
+
+// unitSuffix recognises a registered unit symbol after an amount, with at most one space
+func unitSuffix(text string, i int) (string, int, bool) {
+	start := skipSpace(text, i)
+	end := start
+	for end < len(text) {
+		r, size := utf8.DecodeRuneInString(text[end:])
+		if unicode.IsSpace(r) || strings.ContainsRune(",;:!?()\"", r) {
+			break
+		}
+		end += size
+	}
+
+	// A sentence-ending period is not part of the unit
+	symbol := strings.TrimRight(text[start:end], ".")
+	if symbol == "" {
+		return "", 0, false
+	}
+	// Units are matched case-sensitively here, so "3G" is not three grams
+	if u, ok := converter.LookupUnit(symbol); !ok || u.Symbol != symbol {
+		return "", 0, false
+	}
+	return symbol, start + len(symbol), true
+}
+
+// skipSpace skips a single space
+func skipSpace(text string, i int) int {
+	if i < len(text) && text[i] == ' ' {
+		return i + 1
+	}
+	return i
+}
+
+// skipWord moves past a run of letters, digits and number punctuation
+func skipWord(text string, i int) int {
+	for i < len(text) {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if !isWordRune(r) && !strings.ContainsRune(".,-/:", r) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// atBoundary reports whether a number ending at i is not glued to a letter or digit
+func atBoundary(text string, i int) bool {
+	if i >= len(text) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(text[i:])
+	return !isWordRune(r)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAllDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
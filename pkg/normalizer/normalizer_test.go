@@ -0,0 +1,82 @@
+package normalizer_test
+
+import (
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+	"vietnamese-converter/pkg/normalizer"
+)
+
+func newNormalizer() *normalizer.Normalizer {
+	return normalizer.New(converter.NewTurboConverter().(normalizer.Converter))
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Tổng cộng 1.250.000đ, giảm 10% vào ngày 02/09",
+			"Tổng cộng một triệu hai trăm năm mươi nghìn đồng, giảm mười phần trăm vào ngày hai tháng chín"},
+		{"Giá 50.000 VNĐ, còn 3/4 số hàng.", "Giá năm mươi nghìn đồng, còn ba phần tư số hàng."},
+		{"Gọi 0912345678 lúc 08:05.", "Gọi không chín một hai ba bốn năm sáu bảy tám lúc tám giờ năm phút."},
+		{"Diện tích 25 km², nhiệt độ -5 °C.", "Diện tích hai mươi lăm ki-lô-mét vuông, nhiệt độ âm năm độ C."},
+		{"Tăng 12,5% lên 1,5kg.", "Tăng mười hai phẩy năm phần trăm lên một phẩy năm ki-lô-gam."},
+		{"Hạn chót 15/04/2024.", "Hạn chót ngày mười lăm tháng tư năm hai nghìn không trăm hai mươi tư."},
+		{"Phí 100₫ và 2.500,50 VND", "Phí một trăm đồng và hai nghìn năm trăm đồng năm mươi xu"},
+		{"Năm 2024 có 366 ngày", "Năm hai nghìn không trăm hai mươi tư có ba trăm sáu mươi sáu ngày"},
+		{"Khổ A4, mạng 3G", "Khổ A4, mạng 3G"},
+		{"31/02/2024 không tồn tại", "31/02/2024 không tồn tại"},
+		{"không có số", "không có số"},
+	}
+
+	n := newNormalizer()
+	for _, tt := range tests {
+		if got := n.Normalize(tt.text).Text; got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeSpans(t *testing.T) {
+	text := "Giảm 10% còn 90.000đ"
+	r := newNormalizer().Normalize(text)
+
+	want := []struct {
+		kind     normalizer.Kind
+		original string
+	}{
+		{normalizer.KindPercent, "10%"},
+		{normalizer.KindMoney, "90.000đ"},
+	}
+	if len(r.Spans) != len(want) {
+		t.Fatalf("got %d spans, want %d", len(r.Spans), len(want))
+	}
+
+	for i, s := range r.Spans {
+		if s.Kind != want[i].kind || s.Original != want[i].original {
+			t.Errorf("span %d = %s %q, want %s %q", i, s.Kind, s.Original, want[i].kind, want[i].original)
+		}
+		if text[s.Start:s.End] != s.Original {
+			t.Errorf("span %d original offsets [%d:%d] = %q", i, s.Start, s.End, text[s.Start:s.End])
+		}
+		if r.Text[s.OutStart:s.OutEnd] != s.Replacement {
+			t.Errorf("span %d normalized offsets [%d:%d] = %q", i, s.OutStart, s.OutEnd, r.Text[s.OutStart:s.OutEnd])
+		}
+		if got := r.OriginalOffset(s.OutStart + 1); got != s.Start {
+			t.Errorf("OriginalOffset inside span %d = %d, want %d", i, got, s.Start)
+		}
+	}
+
+	// Text between and after the spans maps back by the accumulated shift
+	between := r.Spans[0].OutEnd + 1
+	if got, want := r.OriginalOffset(between), r.Spans[0].End+1; got != want {
+		t.Errorf("OriginalOffset(%d) = %d, want %d", between, got, want)
+	}
+	if got := r.OriginalOffset(len(r.Text)); got != len(text) {
+		t.Errorf("OriginalOffset(end) = %d, want %d", got, len(text))
+	}
+	if got := r.OriginalOffset(2); got != 2 {
+		t.Errorf("OriginalOffset(2) = %d, want 2", got)
+	}
+}
@@ -32,8 +32,8 @@ func BenchmarkZeroAllocConverter(b *testing.B) {
 			b.ReportAllocs()
 			
 			for i := 0; i < b.N; i++ {
-				result := converter.Convert(tc.number)
-				if len(result) == 0 {
+				result, err := converter.Convert(tc.number)
+				if err != nil || len(result) == 0 {
 					b.Fatal("Empty result")
 				}
 			}
@@ -56,8 +56,8 @@ func BenchmarkZeroAllocConverterRandom(b *testing.B) {
 	
 	for i := 0; i < b.N; i++ {
 		number := numbers[i%len(numbers)]
-		result := converter.Convert(number)
-		if len(result) == 0 {
+		result, err := converter.Convert(number)
+		if err != nil || len(result) == 0 {
 			b.Fatal("Empty result")
 		}
 	}
@@ -70,8 +70,8 @@ func BenchmarkConcurrentLoad(b *testing.B) {
 	b.RunParallel(func(pb *testing.PB) {
 		number := int64(123456789)
 		for pb.Next() {
-			result := converter.Convert(number)
-			if len(result) == 0 {
+			result, err := converter.Convert(number)
+			if err != nil || len(result) == 0 {
 				b.Fatal("Empty result")
 			}
 		}
@@ -111,7 +111,7 @@ func TestPerformanceTarget(t *testing.T) {
 	
 	for _, number := range testNumbers {
 		start := time.Now()
-		result := converter.Convert(number)
+		result, err := converter.Convert(number)
 		elapsed := time.Since(start)
 		
 		if elapsed > targetLatency {
@@ -119,8 +119,8 @@ func TestPerformanceTarget(t *testing.T) {
 				number, elapsed, targetLatency)
 		}
 		
-		if len(result) == 0 {
-			t.Errorf("Empty result for number %d", number)
+		if err != nil || len(result) == 0 {
+			t.Errorf("Empty result for number %d: %v", number, err)
 		}
 		
 		t.Logf("Number: %d, Result: %s, Time: %v", number, result, elapsed)
@@ -173,7 +173,7 @@ func TestCacheEffectiveness(t *testing.T) {
 	// Test that all 3-digit combinations are cached
 	for i := 0; i < 1000; i++ {
 		start := time.Now()
-		result := converter.Convert(int64(i))
+		result, err := converter.Convert(int64(i))
 		elapsed := time.Since(start)
 		
 		// Even cached results should be very fast
@@ -181,8 +181,8 @@ func TestCacheEffectiveness(t *testing.T) {
 			t.Errorf("Cached conversion of %d took %v, too slow", i, elapsed)
 		}
 		
-		if i > 0 && len(result) == 0 {
-			t.Errorf("Empty result for cached number %d", i)
+		if err != nil || len(result) == 0 {
+			t.Errorf("Empty result for cached number %d: %v", i, err)
 		}
 	}
 	
@@ -246,7 +246,11 @@ func BenchmarkLatencyDistribution(b *testing.B) {
 func ExampleZeroAllocConverter() {
 	converter := NewZeroAllocConverter()
 	
-	result := converter.Convert(123456789)
+	result, err := converter.Convert(123456789)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	fmt.Println(result)
 	
 	// Output: một trăm hai mươi ba triệu bốn trăm năm mươi sáu nghìn bảy trăm tám mươi chín đồng
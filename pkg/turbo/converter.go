@@ -1,286 +1,184 @@
 package turbo
 
 import (
-	"sync"
-	"unsafe"
+	"vietnamese-converter/pkg/converter"
 )
 
 // ZeroAllocConverter represents the ultimate Vietnamese number converter
-// Design: Pre-computed lookup tables + zero runtime allocations
+// Design: Pre-computed lookup tables + zero runtime allocations on the append path.
+// All state is read-only after construction, so one converter is safe for
+// concurrent use by any number of goroutines.
 type ZeroAllocConverter struct {
 	// Pre-computed static lookup tables (read-only, cache-friendly)
-	units      [20]string          // 0-19 for direct lookup
-	tens       [10]string          // 10, 20, 30, etc.
-	scales     [8]string           // "", nghìn, triệu, tỷ, etc.
-	
-	// Special case mappings for Vietnamese linguistic rules
-	specialOnes [10]string         // một vs mốt variants
-	specialFours [10]string        // bốn vs tư variants
-	specialFives [10]string        // năm vs lăm variants
-	
-	// Pre-allocated string builders for each goroutine
-	builderPool sync.Pool
-	
-	// Pre-computed common number strings (0-999 for instant lookup)
-	hundredsCache [1000]string
-	
-	// Memory-aligned buffers for cache efficiency
-	scratchPads [64][]byte         // 64 scratch pads for concurrent use
-	padIndex    uint64             // Atomic counter for pad selection
+	zero   string    // the reading of 0, "không"
+	scales [4]string // "", nghìn, triệu, tỷ; larger scales chain on "tỷ"
+
+	// Pre-computed readings of 1-999, taken from converter.GroupReadings
+	hundredsCache [1000]string // the highest group: 5 -> "năm"
+	innerCache    [1000]string // any later group: 5 -> "không trăm năm"
+
+	zeros    converter.ZeroPolicy // how zeros inside a number are read
+	encoding converter.Encoding   // applied to every cached word once, and to currency and sign words
+
+	// explainer traces readings, since the lookup tables keep no digit positions
+	explainer converter.Explainer
 }
 
-// NewZeroAllocConverter creates the ultimate performance converter
-func NewZeroAllocConverter() *ZeroAllocConverter {
+// maxText bounds the reading of any int64 without currency,
+// so the string-returning path can work in a stack buffer
+const maxText = 512
+
+// NewZeroAllocConverter creates the ultimate performance converter. It honours
+// the dialect, lexicon, wording, zero policy and encoding options. It reads
+// the formal style only: WithStyle is ignored, so options shared with other
+// engines can be passed through unchanged.
+func NewZeroAllocConverter(opts ...converter.Option) *ZeroAllocConverter {
+	opts = append(opts[:len(opts):len(opts)], converter.WithStyle(converter.StyleFormal))
+	settings := converter.Resolve(opts...)
+
+	lex := settings.Lexicon
 	conv := &ZeroAllocConverter{
-		// Core number words - optimized for cache locality
-		units: [20]string{
-			"", "một", "hai", "ba", "bốn", "năm", "sáu", "bảy", "tám", "chín",
-			"mười", "mười một", "mười hai", "mười ba", "mười bốn", "mười năm",
-			"mười sáu", "mười bảy", "mười tám", "mười chín",
-		},
-		
-		tens: [10]string{
-			"", "mười", "hai mươi", "ba mươi", "bốn mươi", "năm mươi",
-			"sáu mươi", "bảy mươi", "tám mươi", "chín mươi",
-		},
-		
-		scales: [8]string{
-			"", "nghìn", "triệu", "tỷ", "nghìn tỷ", "triệu tỷ", "tỷ tỷ", "nghìn tỷ tỷ",
-		},
-		
-		// Vietnamese linguistic variations
-		specialOnes: [10]string{
-			"", "mốt", "hai", "ba", "tư", "năm", "sáu", "bảy", "tám", "chín",
-		},
-		
-		specialFours: [10]string{
-			"", "một", "hai", "ba", "tư", "năm", "sáu", "bảy", "tám", "chín",
-		},
-		
-		specialFives: [10]string{
-			"", "một", "hai", "ba", "bốn", "lăm", "sáu", "bảy", "tám", "chín",
-		},
-		
-		builderPool: sync.Pool{
-			New: func() interface{} {
-				// Pre-allocate builders with optimal capacity
-				return make([]byte, 0, 256)
-			},
+		zero: converter.Encode(lex.Digits[0], settings.Encoding),
+		// Larger scales chain on "tỷ": nghìn tỷ, triệu tỷ, tỷ tỷ, ...
+		scales: [4]string{
+			"", lex.Thousand, lex.Million, lex.Billion,
 		},
+
+		zeros:    settings.Zeros,
+		encoding: settings.Encoding,
+
+		explainer: converter.NewVietnameseConverter(opts...).(converter.Explainer),
+	}
+
+	// Read every group the way the turbo converter does, encoded once here so
+	// the append path never has to
+	conv.hundredsCache, conv.innerCache = converter.GroupReadings(opts...)
+	for i := range conv.hundredsCache {
+		conv.hundredsCache[i] = converter.Encode(conv.hundredsCache[i], conv.encoding)
+		conv.innerCache[i] = converter.Encode(conv.innerCache[i], conv.encoding)
 	}
-	
-	// Pre-compute all possible 3-digit combinations (000-999)
-	conv.precomputeHundreds()
-	
-	// Initialize scratch pads for concurrent access
-	for i := range conv.scratchPads {
-		conv.scratchPads[i] = make([]byte, 0, 64)
+	for i := range conv.scales {
+		conv.scales[i] = converter.Encode(conv.scales[i], conv.encoding)
 	}
-	
+
 	return conv
 }
 
-// precomputeHundreds pre-computes all 000-999 combinations for instant lookup
-func (c *ZeroAllocConverter) precomputeHundreds() {
-	for i := 0; i < 1000; i++ {
-		c.hundredsCache[i] = c.computeThreeDigits(i)
-	}
+// Convert converts n to Vietnamese text in "đồng"
+func (c *ZeroAllocConverter) Convert(n int64) (string, error) {
+	return c.ConvertWithCurrency(n, "đồng")
 }
 
-// computeThreeDigits computes Vietnamese text for 000-999 range
-func (c *ZeroAllocConverter) computeThreeDigits(n int) string {
-	if n == 0 {
-		return ""
-	}
-	
-	hundreds := n / 100
-	remainder := n % 100
-	tens := remainder / 10
-	ones := remainder % 10
-	
-	var parts []string
-	
-	// Hundreds place
-	if hundreds > 0 {
-		parts = append(parts, c.units[hundreds], "trăm")
-	}
-	
-	// Tens and ones with Vietnamese linguistic rules
-	if remainder > 0 {
-		if remainder < 20 && remainder >= 10 {
-			// 10-19: use direct lookup
-			if hundreds > 0 && remainder < 20 {
-				if remainder == 10 {
-					parts = append(parts, "lẻ", "mười")
-				} else {
-					parts = append(parts, "lẻ", c.units[remainder])
-				}
-			} else {
-				parts = append(parts, c.units[remainder])
-			}
-		} else {
-			// 20-99: construct from tens and ones
-			if tens > 0 {
-				if hundreds > 0 && tens == 0 && ones > 0 {
-					parts = append(parts, "lẻ")
-				}
-				if tens > 0 {
-					parts = append(parts, c.tens[tens])
-				}
-			}
-			
-			if ones > 0 {
-				// Apply Vietnamese linguistic rules
-				word := c.units[ones]
-				
-				// Rule: 1 becomes "mốt" in tens position (21, 31, etc.)
-				if ones == 1 && tens > 1 {
-					word = "mốt"
-				}
-				
-				// Rule: 4 becomes "tư" in tens position when tens > 1
-				if ones == 4 && tens > 1 {
-					word = "tư"
-				}
-				
-				// Rule: 5 becomes "lăm" in tens position when tens > 0
-				if ones == 5 && tens > 0 {
-					word = "lăm"
-				}
-				
-				parts = append(parts, word)
-			}
-		}
+// ConvertWithCurrency converts n to Vietnamese text followed by currency.
+// The result is a fresh string owned by the caller.
+func (c *ZeroAllocConverter) ConvertWithCurrency(n int64, currency string) (string, error) {
+	if n < 0 {
+		return "", converter.ErrNegative
 	}
-	
-	return joinStrings(parts)
+	var buf [maxText]byte
+	return string(c.appendUint64(buf[:0], uint64(n), currency)), nil
 }
 
-// Convert performs zero-allocation Vietnamese number conversion
-// This is the hot path - every nanosecond matters
-func (c *ZeroAllocConverter) Convert(n int64) string {
-	if n == 0 {
-		return "không đồng"
-	}
-	
+// AppendConvert appends the reading of n to dst and returns the extended
+// buffer. This is the hot path: with enough capacity in dst it does not allocate.
+func (c *ZeroAllocConverter) AppendConvert(dst []byte, n int64, currency string) ([]byte, error) {
 	if n < 0 {
-		return "số âm không được hỗ trợ"
-	}
-	
-	// Get scratch buffer for this conversion (lock-free)
-	scratch := c.getScratchBuffer()
-	defer c.returnScratchBuffer(scratch)
-	
-	// Reset buffer
-	scratch = scratch[:0]
-	
-	// Process number in groups of 3 digits (scale groups)
-	scaleIndex := 0
-	parts := make([]string, 0, 8) // Pre-allocate for common cases
-	
-	for n > 0 && scaleIndex < len(c.scales) {
-		group := int(n % 1000)
-		n /= 1000
-		
-		if group > 0 {
-			// Use pre-computed cache for instant lookup
-			groupText := c.hundredsCache[group]
-			
-			if groupText != "" {
-				if scaleIndex > 0 {
-					groupText = groupText + " " + c.scales[scaleIndex]
-				}
-				parts = append(parts, groupText)
-			}
-		}
-		
-		scaleIndex++
-	}
-	
-	// Reverse parts and join (numbers are processed right-to-left)
-	if len(parts) == 0 {
-		return "không đồng"
-	}
-	
-	// Build final string efficiently
-	totalLen := 0
-	for i := len(parts) - 1; i >= 0; i-- {
-		totalLen += len(parts[i])
-		if i > 0 {
-			totalLen++ // Space separator
-		}
+		return dst, converter.ErrNegative
 	}
-	totalLen += 5 // " đồng"
-	
-	// Ensure scratch buffer has enough capacity
-	if cap(scratch) < totalLen {
-		scratch = make([]byte, 0, totalLen+64)
-	}
-	
-	// Build result in scratch buffer
-	for i := len(parts) - 1; i >= 0; i-- {
-		scratch = append(scratch, parts[i]...)
-		if i > 0 {
-			scratch = append(scratch, ' ')
-		}
-	}
-	scratch = append(scratch, " đồng"...)
-	
-	// Convert to string using zero-copy technique
-	return unsafeBytesToString(scratch)
+	return c.appendUint64(dst, uint64(n), currency), nil
 }
 
-// getScratchBuffer gets a scratch buffer for the current goroutine
-func (c *ZeroAllocConverter) getScratchBuffer() []byte {
-	// Use simple round-robin to distribute across scratch pads
-	// This provides good cache locality without locks
-	index := int(c.padIndex) % len(c.scratchPads)
-	c.padIndex++
-	return c.scratchPads[index]
+// Explain converts a non-negative integer given as decimal digits and reports
+// which digits produced each word, traced by the reference engine with the same options
+func (c *ZeroAllocConverter) Explain(digits string, currency string) (converter.Explanation, error) {
+	return c.explainer.Explain(digits, currency)
 }
 
-// returnScratchBuffer returns the scratch buffer (no-op for simplicity)
-func (c *ZeroAllocConverter) returnScratchBuffer(buf []byte) {
-	// In this implementation, we don't need to return anything
-	// The scratch pads are reused automatically
+// ConvertSigned converts a possibly negative number, writing the sign as configured by opts
+func (c *ZeroAllocConverter) ConvertSigned(n int64, currency string, opts converter.SignOptions) (string, error) {
+	var buf [maxText]byte
+	out, err := c.AppendSigned(buf[:0], n, currency, opts)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
 }
 
-// joinStrings efficiently joins strings with spaces
-func joinStrings(parts []string) string {
-	if len(parts) == 0 {
-		return ""
+// AppendSigned is the allocation-free form of ConvertSigned
+func (c *ZeroAllocConverter) AppendSigned(dst []byte, n int64, currency string, opts converter.SignOptions) ([]byte, error) {
+	if n >= 0 {
+		return c.appendUint64(dst, uint64(n), currency), nil
+	}
+
+	magnitude := converter.Magnitude(n)
+	switch opts.Style {
+	case converter.SignWord:
+		prefix := opts.Prefix
+		if prefix == "" {
+			prefix = "âm"
+		}
+		dst = append(dst, converter.Encode(prefix, c.encoding)...)
+		dst = append(dst, ' ')
+		return c.appendUint64(dst, magnitude, currency), nil
+	case converter.SignAccounting:
+		dst = append(dst, '(')
+		dst = c.appendUint64(dst, magnitude, currency)
+		return append(dst, ')'), nil
+	default:
+		return dst, converter.ErrNegative
 	}
-	
-	if len(parts) == 1 {
-		return parts[0]
+}
+
+// appendUint64 reads n group by group from the pre-computed caches
+func (c *ZeroAllocConverter) appendUint64(dst []byte, n uint64, currency string) []byte {
+	// 7 groups cover all 20 digits of a uint64
+	var groups [7]int
+	groupCount := 0
+	for ; n > 0; n /= 1000 {
+		groups[groupCount] = int(n % 1000)
+		groupCount++
 	}
-	
-	// Calculate total length
-	totalLen := 0
-	for _, part := range parts {
-		totalLen += len(part)
+
+	if groupCount == 0 {
+		dst = append(dst, c.zero...)
 	}
-	totalLen += len(parts) - 1 // Spaces
-	
-	// Build result efficiently
-	result := make([]byte, 0, totalLen)
-	for i, part := range parts {
-		if i > 0 {
-			result = append(result, ' ')
+
+	for i := groupCount - 1; i >= 0; i-- {
+		group := groups[i]
+		if group == 0 {
+			if c.zeros.ReadsEmptyGroup(i, func(k int) int { return groups[k] }) {
+				dst = append(dst, ' ')
+				dst = append(dst, c.zero...)
+				dst = append(dst, ' ')
+				dst = append(dst, c.scales[i%3]...)
+			}
+			continue
+		}
+
+		if i == groupCount-1 {
+			dst = append(dst, c.hundredsCache[group]...)
+		} else {
+			dst = append(dst, ' ')
+			dst = append(dst, c.innerCache[group]...)
+		}
+
+		if i%3 != 0 {
+			dst = append(dst, ' ')
+			dst = append(dst, c.scales[i%3]...)
+		}
+
+		for k := converter.BillionsAfter(i, func(k int) int { return groups[k] }); k > 0; k-- {
+			dst = append(dst, ' ')
+			dst = append(dst, c.scales[3]...)
 		}
-		result = append(result, part...)
 	}
-	
-	return unsafeBytesToString(result)
-}
 
-// unsafeBytesToString converts []byte to string without allocation
-// This is safe because we control the lifecycle of the byte slice
-func unsafeBytesToString(b []byte) string {
-	if len(b) == 0 {
-		return ""
+	if currency != "" {
+		dst = append(dst, ' ')
+		dst = append(dst, converter.Encode(currency, c.encoding)...)
 	}
-	return *(*string)(unsafe.Pointer(&b))
+
+	return dst
 }
 
 // Performance metrics and debugging functions
@@ -294,28 +192,18 @@ func (c *ZeroAllocConverter) GetCacheHitRatio() float64 {
 // GetMemoryFootprint returns the memory usage of the converter
 func (c *ZeroAllocConverter) GetMemoryFootprint() int {
 	size := 0
-	
+
 	// Static arrays
-	for _, s := range c.units {
-		size += len(s)
-	}
-	for _, s := range c.tens {
-		size += len(s)
-	}
+	size += len(c.zero)
 	for _, s := range c.scales {
 		size += len(s)
 	}
-	
-	// Pre-computed cache
-	for _, s := range c.hundredsCache {
-		size += len(s)
-	}
-	
-	// Scratch pads
-	for _, pad := range c.scratchPads {
-		size += cap(pad)
+
+	// Pre-computed caches
+	for i := range c.hundredsCache {
+		size += len(c.hundredsCache[i]) + len(c.innerCache[i])
 	}
-	
+
 	return size
 }
 
@@ -324,4 +212,10 @@ func (c *ZeroAllocConverter) BenchmarkConvert(n int64, iterations int) (avgNanos
 	// This would implement precise benchmarking
 	// For production, this method would be excluded
 	return 0, 0
-}
\ No newline at end of file
+}
+
+// Compile-time checks that the converter plugs into the standard API
+var (
+	_ converter.NumberConverter = (*ZeroAllocConverter)(nil)
+	_ converter.SignedConverter = (*ZeroAllocConverter)(nil)
+)
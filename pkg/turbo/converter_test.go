@@ -0,0 +1,196 @@
+package turbo
+
+import (
+	"errors"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"vietnamese-converter/pkg/converter"
+	"vietnamese-converter/pkg/converter/testutil"
+)
+
+func TestZeroAllocConverter_FullDataset(t *testing.T) {
+	suite := testutil.NewTestSuiteFor(NewZeroAllocConverter())
+	results, err := suite.RunAllTests("../../random_numbers_with_vietnamese.txt")
+	if err != nil {
+		if os.IsNotExist(err) {
+			t.Skip("Test data file not found, skipping full dataset test")
+		}
+		t.Fatalf("Error running tests: %v", err)
+	}
+
+	report := suite.GenerateReport(results)
+	for i, result := range report.FailedCases {
+		if i >= 10 {
+			t.Errorf("... and %d more failures", len(report.FailedCases)-10)
+			break
+		}
+		t.Errorf("%d: expected %q, got %q", result.TestCase.Number, result.Expected, result.ActualResult)
+	}
+	if report.ErrorTests > 0 {
+		t.Errorf("%d conversions returned an error", report.ErrorTests)
+	}
+}
+
+func TestZeroAllocConverter_Convert(t *testing.T) {
+	tests := []struct {
+		number   int64
+		currency string
+		want     string
+	}{
+		{0, "đồng", "không đồng"},
+		{15, "đồng", "mười lăm đồng"},
+		{105, "đồng", "một trăm lẻ năm đồng"},
+		{110, "đồng", "một trăm mười đồng"},
+		{1005, "đồng", "một nghìn không trăm năm đồng"},
+		{1010, "đồng", "một nghìn không trăm mười đồng"},
+		{21000, "", "hai mươi mốt nghìn"},
+		{1000000, "USD", "một triệu USD"},
+		{1020000000000, "đồng", "một nghìn không trăm hai mươi tỷ đồng"},
+		{math.MaxInt64, "",
+			"chín tỷ tỷ hai trăm hai mươi ba triệu ba trăm bảy mươi hai nghìn không trăm ba mươi sáu tỷ " +
+				"tám trăm năm mươi tư triệu bảy trăm bảy mươi lăm nghìn tám trăm lẻ bảy"},
+	}
+
+	conv := NewZeroAllocConverter()
+	for _, tt := range tests {
+		got, err := conv.ConvertWithCurrency(tt.number, tt.currency)
+		if err != nil {
+			t.Errorf("ConvertWithCurrency(%d, %q) error: %v", tt.number, tt.currency, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ConvertWithCurrency(%d, %q) = %q, want %q", tt.number, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestZeroAllocConverter_Signed(t *testing.T) {
+	tests := []struct {
+		number int64
+		opts   converter.SignOptions
+		want   string
+	}{
+		{-5, converter.SignOptions{Style: converter.SignWord}, "âm năm đồng"},
+		{-5, converter.SignOptions{Style: converter.SignWord, Prefix: "trừ"}, "trừ năm đồng"},
+		{-5, converter.SignOptions{Style: converter.SignAccounting}, "(năm đồng)"},
+		{5, converter.SignOptions{}, "năm đồng"},
+	}
+
+	conv := NewZeroAllocConverter()
+	for _, tt := range tests {
+		got, err := conv.ConvertSigned(tt.number, "đồng", tt.opts)
+		if err != nil || got != tt.want {
+			t.Errorf("ConvertSigned(%d, %+v) = %q, %v, want %q", tt.number, tt.opts, got, err, tt.want)
+		}
+	}
+
+	if _, err := conv.Convert(-1); !errors.Is(err, converter.ErrNegative) {
+		t.Errorf("Convert(-1) error = %v, want ErrNegative", err)
+	}
+	if _, err := conv.ConvertSigned(-1, "đồng", converter.SignOptions{}); !errors.Is(err, converter.ErrNegative) {
+		t.Errorf("ConvertSigned(-1) with SignReject error = %v, want ErrNegative", err)
+	}
+	if got, err := conv.ConvertSigned(math.MinInt64, "", converter.SignOptions{Style: converter.SignWord}); err != nil ||
+		got != "âm chín tỷ tỷ hai trăm hai mươi ba triệu ba trăm bảy mươi hai nghìn không trăm ba mươi sáu tỷ "+
+			"tám trăm năm mươi tư triệu bảy trăm bảy mươi lăm nghìn tám trăm lẻ tám" {
+		t.Errorf("ConvertSigned(MinInt64) = %q, %v", got, err)
+	}
+}
+
+func TestZeroAllocConverter_AppendConvertAllocs(t *testing.T) {
+	conv := NewZeroAllocConverter()
+	buf := make([]byte, 0, 512)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = conv.AppendConvert(buf[:0], 123456789012, "đồng")
+	})
+	if allocs != 0 {
+		t.Errorf("AppendConvert allocated %.1f times per run, want 0", allocs)
+	}
+}
+
+// TestZeroAllocConverter_Concurrent checks that results returned to one
+// goroutine are never overwritten by another; run it with -race.
+func TestZeroAllocConverter_Concurrent(t *testing.T) {
+	conv := NewZeroAllocConverter()
+
+	numbers := []int64{0, 7, 110, 1005, 123456789, 1020000000000, math.MaxInt64}
+	want := make([]string, len(numbers))
+	for i, n := range numbers {
+		want[i], _ = conv.Convert(n)
+	}
+
+	const goroutines = 64
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			held := make([]string, 0, 500)
+			for j := 0; j < 500; j++ {
+				i := (g + j) % len(numbers)
+				got, err := conv.Convert(numbers[i])
+				if err != nil || got != want[i] {
+					t.Errorf("Convert(%d) = %q, %v, want %q", numbers[i], got, err, want[i])
+					return
+				}
+				held = append(held, got)
+			}
+			// Strings kept across calls must still read the same
+			for j, got := range held {
+				if i := (g + j) % len(numbers); got != want[i] {
+					t.Errorf("held result for %d changed to %q", numbers[i], got)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestZeroAllocConverterOptions(t *testing.T) {
+	optionSets := map[string][]converter.Option{
+		"southern":      {converter.WithDialect(converter.DialectSouthern)},
+		"bon_nham":      {converter.WithFourAsBon(), converter.WithFiveAsNham()},
+		"lexicon":       {converter.WithLexicon(converter.SouthernLexicon())},
+		"ascii":         {converter.WithEncoding(converter.EncodingASCII)},
+		"nfd":           {converter.WithEncoding(converter.EncodingNFD)},
+		"zero_policy":   {converter.WithZeroPolicy(converter.ZeroPolicy{OmitZeroHundreds: true, OddZeroAlways: true, ReadEmptyGroups: true})},
+		"southern_zero": {converter.WithDialect(converter.DialectSouthern), converter.WithZeroPolicy(converter.ZeroPolicy{OddZeroAlways: true})},
+	}
+	numbers := []int64{0, 5, 15, 21, 24, 25, 105, 1005, 1_000_005, 1_105_000, 2_024_000_000, math.MaxInt64}
+
+	for name, opts := range optionSets {
+		conv := NewZeroAllocConverter(opts...)
+		reference := converter.NewTurboConverter(opts...).(converter.SignedConverter)
+		for _, n := range numbers {
+			for _, number := range []int64{n, -n} {
+				sign := converter.SignOptions{Style: converter.SignWord}
+				got, err := conv.ConvertSigned(number, "đồng", sign)
+				want, wantErr := reference.ConvertSigned(number, "đồng", sign)
+				if got != want || (err == nil) != (wantErr == nil) {
+					t.Errorf("%s: ConvertSigned(%d) = %q, %v, want %q, %v", name, number, got, err, want, wantErr)
+				}
+			}
+		}
+	}
+}
+
+func TestZeroAllocConverterIgnoresStyle(t *testing.T) {
+	conv := NewZeroAllocConverter(converter.WithStyle(converter.StyleColloquial), converter.WithDialect(converter.DialectSouthern))
+	reference := converter.NewTurboConverter(converter.WithDialect(converter.DialectSouthern))
+	for _, n := range []int64{21, 150, 1_500_000, 1_250_000} {
+		got, err := conv.ConvertWithCurrency(n, "")
+		want, _ := reference.ConvertWithCurrency(n, "")
+		if err != nil || got != want {
+			t.Errorf("ConvertWithCurrency(%d) = %q, %v, want the formal %q", n, got, err, want)
+		}
+		explanation, err := conv.Explain(strconv.FormatInt(n, 10), "")
+		if err != nil || explanation.Text() != want {
+			t.Errorf("Explain(%d) = %q, %v, want %q", n, explanation.Text(), err, want)
+		}
+	}
+}
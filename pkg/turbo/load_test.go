@@ -3,7 +3,9 @@ package turbo
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -60,7 +62,7 @@ func TestLoad1000RPS(t *testing.T) {
 	}
 	
 	// Verify results
-	if result.ActualRPS < float64(config.TargetRPS*0.95) { // 95% of target
+	if result.ActualRPS < float64(config.TargetRPS)*0.95 { // 95% of target
 		t.Errorf("Failed to achieve target RPS. Got %.1f, wanted >= %.1f", 
 			result.ActualRPS, float64(config.TargetRPS)*0.95)
 	}
@@ -160,18 +162,13 @@ func runLoadTest(config LoadTestConfig, url string) (*LoadTestResult, error) {
 	
 	// Request generator
 	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
-		
 		endTime := start.Add(config.Duration)
 		
-		for time.Now().Before(endTime) {
-			select {
-			case requestChan <- true:
-			case <-ticker.C:
-				// Continue to next tick if channel is full
-			}
-			<-ticker.C
+		// Pace against a fixed schedule rather than a ticker, which drops
+		// ticks and would cap the offered load below the target
+		for next := start; next.Before(endTime); next = next.Add(interval) {
+			time.Sleep(time.Until(next))
+			requestChan <- true
 		}
 		
 		close(done)
@@ -227,9 +224,8 @@ func sortLatencies(latencies []time.Duration) {
 }
 
 // bytesReader creates a reader from string (helper function)
-func bytesReader(s string) *http.Request {
-	req, _ := http.NewRequest("POST", "", nil)
-	return req
+func bytesReader(s string) io.Reader {
+	return strings.NewReader(s)
 }
 
 // BenchmarkServiceThroughput measures end-to-end service throughput
@@ -326,8 +322,8 @@ func TestGracefulDegradation(t *testing.T) {
 			defer wg.Done()
 			
 			for j := 0; j < iterations; j++ {
-				result := converter.Convert(123456789)
-				if len(result) == 0 {
+				result, err := converter.Convert(123456789)
+				if err != nil || len(result) == 0 {
 					atomic.AddInt64(&errors, 1)
 				}
 			}
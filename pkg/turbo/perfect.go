@@ -2,15 +2,19 @@ package turbo
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
+
+	"vietnamese-converter/pkg/converter"
 )
 
 // PerfectService represents the ultimate Vietnamese converter service
@@ -190,29 +194,46 @@ func (s *PerfectService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// handleConvert processes conversion requests with zero allocations
+// handleConvert processes conversion requests, converting straight into a pooled buffer
 func (s *PerfectService) handleConvert(w *FastResponseWriter, r *http.Request) {
 	// Get buffer from pool
 	buf := s.responsePool.buffers.Get().([]byte)
 	buf = buf[:0] // Reset length, keep capacity
 	defer s.responsePool.buffers.Put(buf)
 	
-	// Parse number directly from body without JSON unmarshaling
-	number, err := s.parseNumberFromBody(r)
+	req, err := parseConvertRequest(r)
 	if err != nil {
 		atomic.AddUint64(&s.metrics.errorCount, 1)
 		w.WriteHeader(400)
 		return
 	}
 	
-	// Convert using zero-allocation converter
-	vietnamese := s.converter.Convert(number)
+	// Negative amounts are opt-in, as in the standard API
+	var sign converter.SignOptions
+	switch req.NegativeStyle {
+	case "":
+	case "word":
+		sign.Style = converter.SignWord
+	case "accounting":
+		sign.Style = converter.SignAccounting
+	default:
+		atomic.AddUint64(&s.metrics.errorCount, 1)
+		w.WriteHeader(400)
+		return
+	}
+	// The prefix is written inside the JSON string, so it goes in escaped
+	sign.Prefix = jsonEscape(req.NegativePrefix)
 	
-	// Build JSON response directly in buffer (zero allocation)
+	// Build JSON response directly in buffer, converting in place
 	buf = append(buf, `{"number":`...)
-	buf = appendInt(buf, number)
+	buf = strconv.AppendInt(buf, req.number, 10)
 	buf = append(buf, `,"vietnamese":"`...)
-	buf = append(buf, vietnamese...)
+	buf, err = s.converter.AppendSigned(buf, req.number, "đồng", sign)
+	if err != nil {
+		atomic.AddUint64(&s.metrics.errorCount, 1)
+		w.WriteHeader(400)
+		return
+	}
 	buf = append(buf, `"}`...)
 	
 	// Write response
@@ -272,100 +293,39 @@ func (s *PerfectService) Shutdown(ctx context.Context) error {
 
 // Utility functions for zero-allocation operations
 
-// parseNumberFromBody extracts number from request body without JSON parsing
-func (s *PerfectService) parseNumberFromBody(r *http.Request) (int64, error) {
-	// This would implement a direct parser for {"number":123} format
-	// For now, simplified version
-	buf := make([]byte, r.ContentLength)
-	n, err := r.Body.Read(buf)
-	if err != nil && n == 0 {
-		return 0, err
-	}
+// convertRequest is the body of a conversion request
+type convertRequest struct {
+	Number         json.Number `json:"number"`
+	NegativeStyle  string      `json:"negative_style"`
+	NegativePrefix string      `json:"negative_prefix"`
 	
-	// Find number in JSON using byte scanning (zero allocation)
-	return extractNumberFromJSON(buf[:n])
+	number int64
 }
 
-// extractNumberFromJSON finds number value in JSON without parsing
-func extractNumberFromJSON(data []byte) (int64, error) {
-	// Simple state machine to find "number": value
-	state := 0 // 0=looking for "number", 1=looking for :, 2=looking for value
-	start := -1
-	
-	for i, b := range data {
-		switch state {
-		case 0:
-			if b == '"' && i+6 < len(data) && string(data[i:i+8]) == `"number"` {
-				state = 1
-				i += 7 // Skip the rest of "number"
-			}
-		case 1:
-			if b == ':' {
-				state = 2
-			}
-		case 2:
-			if b >= '0' && b <= '9' {
-				start = i
-				for j := i; j < len(data); j++ {
-					if data[j] < '0' || data[j] > '9' {
-						return parseIntFromBytes(data[start:j])
-					}
-				}
-				return parseIntFromBytes(data[start:])
-			}
-		}
+// parseConvertRequest decodes {"number":123} with the optional negative_style and negative_prefix
+func parseConvertRequest(r *http.Request) (convertRequest, error) {
+	var req convertRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return req, err
 	}
-	
-	return 0, fmt.Errorf("number not found")
-}
-
-// parseIntFromBytes converts byte slice to int64 without allocation
-func parseIntFromBytes(data []byte) (int64, error) {
-	if len(data) == 0 {
-		return 0, fmt.Errorf("empty data")
+	if req.Number == "" {
+		return req, fmt.Errorf("number not found")
 	}
-	
-	var result int64
-	for _, b := range data {
-		if b < '0' || b > '9' {
-			break
-		}
-		result = result*10 + int64(b-'0')
+	number, err := strconv.ParseInt(req.Number.String(), 10, 64)
+	if err != nil {
+		return req, err
 	}
-	
-	return result, nil
+	req.number = number
+	return req, nil
 }
 
-// appendInt appends integer to byte slice without allocation
-func appendInt(buf []byte, n int64) []byte {
-	if n == 0 {
-		return append(buf, '0')
+// jsonEscape escapes s for use inside a JSON string
+func jsonEscape(s string) string {
+	if s == "" {
+		return s
 	}
-	
-	// Calculate digits
-	temp := n
-	digits := 0
-	for temp > 0 {
-		digits++
-		temp /= 10
-	}
-	
-	// Ensure capacity
-	if cap(buf)-len(buf) < digits {
-		newBuf := make([]byte, len(buf), len(buf)+digits+10)
-		copy(newBuf, buf)
-		buf = newBuf
-	}
-	
-	// Add digits in reverse
-	start := len(buf)
-	buf = buf[:len(buf)+digits]
-	for i := digits - 1; i >= 0; i-- {
-		buf[start+i] = byte('0' + n%10)
-		n /= 10
-	}
-	
-	return buf
+	quoted, _ := json.Marshal(s)
+	return string(quoted[1 : len(quoted)-1])
 }
 
 // unsafeString converts int to string without allocation
@@ -376,7 +336,7 @@ func unsafeString(n int) string {
 	
 	// For larger numbers, use itoa
 	buf := make([]byte, 0, 10)
-	buf = appendInt(buf, int64(n))
+	buf = strconv.AppendInt(buf, int64(n), 10)
 	return *(*string)(unsafe.Pointer(&buf))
 }
 
@@ -0,0 +1,61 @@
+package turbo
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPerfectServiceConvert(t *testing.T) {
+	s := NewPerfectService()
+
+	tests := []struct {
+		body string
+		code int
+		want string
+	}{
+		{`{"number":1005}`, 200, "một nghìn không trăm năm đồng"},
+		{`{"number":-5,"negative_style":"word"}`, 200, "âm năm đồng"},
+		{`{"number":-5,"negative_style":"word","negative_prefix":"trừ"}`, 200, "trừ năm đồng"},
+		{`{"number":-5,"negative_style":"word","negative_prefix":"\"x\""}`, 200, `"x" năm đồng`},
+		{`{"number":-5,"negative_style":"accounting"}`, 200, "(năm đồng)"},
+		{`{"number":-9223372036854775808,"negative_style":"word"}`, 200, "âm chín tỷ tỷ hai trăm hai mươi ba triệu ba trăm bảy mươi hai nghìn " +
+			"không trăm ba mươi sáu tỷ tám trăm năm mươi tư triệu bảy trăm bảy mươi lăm nghìn tám trăm lẻ tám đồng"},
+		// A key name inside a string value is not the key
+		{`{"negative_prefix":"\"number\":7","number":-5,"negative_style":"word"}`, 200, `"number":7 năm đồng`},
+		{`{"number":-5}`, 400, ""},
+		{`{"number":5,"negative_style":"minus"}`, 400, ""},
+		{`{"number":"abc"}`, 400, ""},
+		{`{}`, 400, ""},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest("POST", "/convert", strings.NewReader(tt.body)))
+		if rec.Code != tt.code {
+			t.Errorf("%s: status %d, want %d", tt.body, rec.Code, tt.code)
+			continue
+		}
+		if tt.code != 200 {
+			continue
+		}
+
+		var resp struct {
+			Number     json.Number `json:"number"`
+			Vietnamese string      `json:"vietnamese"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Errorf("%s: invalid JSON %q: %v", tt.body, rec.Body.String(), err)
+			continue
+		}
+		var req struct{ Number json.Number }
+		json.Unmarshal([]byte(tt.body), &req)
+		if resp.Number != req.Number {
+			t.Errorf("%s: number = %s, want %s", tt.body, resp.Number, req.Number)
+		}
+		if resp.Vietnamese != tt.want {
+			t.Errorf("%s: vietnamese = %q, want %q", tt.body, resp.Vietnamese, tt.want)
+		}
+	}
+}